@@ -0,0 +1,119 @@
+package govh
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Methods allowed in access rules.
+var accessRuleMethods = []string{"GET", "POST", "PUT", "DELETE"}
+
+// ReadOnlyRules returns rules allowing GET on each of paths.
+func ReadOnlyRules(paths ...string) []*AccessRule {
+	return rulesFor([]string{"GET"}, paths)
+}
+
+// ReadWriteRules returns rules allowing GET, POST, PUT and DELETE on each of
+// paths.
+func ReadWriteRules(paths ...string) []*AccessRule {
+	return rulesFor(accessRuleMethods, paths)
+}
+
+// AllRules returns rules allowing every call on the whole API.
+func AllRules() []*AccessRule {
+	return ReadWriteRules("/*")
+}
+
+func rulesFor(methods, paths []string) []*AccessRule {
+	rules := make([]*AccessRule, 0, len(methods)*len(paths))
+	for _, path := range paths {
+		for _, method := range methods {
+			rules = append(rules, &AccessRule{Method: method, Path: path})
+		}
+	}
+	return rules
+}
+
+// RuleSet builds a list of access rules:
+//
+//	rules, err := govh.NewRuleSet().
+//		Get("/me", "/domain/*").
+//		ReadWrite("/domain/zone/example.com/*").
+//		Build()
+//
+// Invalid methods or paths are reported by Build.
+type RuleSet struct {
+	rules []*AccessRule
+	seen  map[AccessRule]bool
+	errs  []string
+}
+
+// NewRuleSet creates an empty rule set.
+func NewRuleSet() *RuleSet {
+	return &RuleSet{seen: map[AccessRule]bool{}}
+}
+
+// Add allows method on each of paths.
+func (set *RuleSet) Add(method string, paths ...string) *RuleSet {
+	method = strings.ToUpper(method)
+
+	valid := false
+	for _, m := range accessRuleMethods {
+		valid = valid || m == method
+	}
+	if !valid {
+		set.errs = append(set.errs, fmt.Sprintf("invalid method %q", method))
+		return set
+	}
+
+	for _, path := range paths {
+		if !strings.HasPrefix(path, "/") {
+			set.errs = append(set.errs, fmt.Sprintf("invalid path %q, must start with /", path))
+			continue
+		}
+
+		rule := AccessRule{Method: method, Path: path}
+		if set.seen[rule] {
+			continue
+		}
+		set.seen[rule] = true
+		set.rules = append(set.rules, &rule)
+	}
+	return set
+}
+
+// Get allows GET on each of paths.
+func (set *RuleSet) Get(paths ...string) *RuleSet {
+	return set.Add("GET", paths...)
+}
+
+// Post allows POST on each of paths.
+func (set *RuleSet) Post(paths ...string) *RuleSet {
+	return set.Add("POST", paths...)
+}
+
+// Put allows PUT on each of paths.
+func (set *RuleSet) Put(paths ...string) *RuleSet {
+	return set.Add("PUT", paths...)
+}
+
+// Delete allows DELETE on each of paths.
+func (set *RuleSet) Delete(paths ...string) *RuleSet {
+	return set.Add("DELETE", paths...)
+}
+
+// ReadWrite allows GET, POST, PUT and DELETE on each of paths.
+func (set *RuleSet) ReadWrite(paths ...string) *RuleSet {
+	for _, method := range accessRuleMethods {
+		set.Add(method, paths...)
+	}
+	return set
+}
+
+// Build returns the rules of the set, or an error listing invalid rules.
+func (set *RuleSet) Build() ([]*AccessRule, error) {
+	if len(set.errs) > 0 {
+		return nil, fmt.Errorf("Invalid access rules: %s", strings.Join(set.errs, ", "))
+	}
+	return set.rules, nil
+}
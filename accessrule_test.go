@@ -0,0 +1,28 @@
+package govh
+
+import "testing"
+
+func TestRuleSet(t *testing.T) {
+	rules, err := NewRuleSet().
+		Get("/me", "/domain/*").
+		ReadWrite("/domain/zone/example.com/*").
+		Add("get", "/me").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 6 {
+		t.Fatalf("expected 6 rules, got %d", len(rules))
+	}
+	if *rules[0] != (AccessRule{Method: "GET", Path: "/me"}) || *rules[5] != (AccessRule{Method: "DELETE", Path: "/domain/zone/example.com/*"}) {
+		t.Fatalf("unexpected rules %v %v", rules[0], rules[5])
+	}
+
+	if _, err := NewRuleSet().Add("PATCH", "/me").Get("me").Build(); err == nil {
+		t.Fatal("expected an error for invalid rules")
+	}
+
+	if all := AllRules(); len(all) != 4 || all[0].Path != "/*" {
+		t.Fatalf("unexpected rules %v", all)
+	}
+}
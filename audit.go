@@ -0,0 +1,140 @@
+package govh
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// AuditRecord describes a call modifying resources (POST, PUT, DELETE).
+// Records are chained by their hashes, so that removing or altering a record
+// can be detected with VerifyAuditTrail.
+type AuditRecord struct {
+	Time   time.Time `json:"time"`
+	Method string    `json:"method"`
+	Path   string    `json:"path"`
+	// Request body, truncated and without the caller's secrets.
+	Body string `json:"body,omitempty"`
+	// HTTP status code of the response, zero if there is none.
+	StatusCode int `json:"statusCode,omitempty"`
+	// Error of the call, if any.
+	Error string `json:"error,omitempty"`
+	// Query ID given by the API.
+	QueryID string `json:"queryId,omitempty"`
+	// Whether the call was intercepted in dry-run mode.
+	DryRun bool `json:"dryRun,omitempty"`
+	// Hash of the previous record, and of this one.
+	PrevHash string `json:"prevHash"`
+	Hash     string `json:"hash"`
+}
+
+// computeHash returns the hash of the record, computed over all its fields
+// but Hash.
+func (record AuditRecord) computeHash() string {
+	record.Hash = ""
+	data, _ := json.Marshal(record)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// AuditSink stores audit records.
+type AuditSink interface {
+	Record(record *AuditRecord) error
+}
+
+// AuditSinkFunc is an AuditSink calling a function.
+type AuditSinkFunc func(record *AuditRecord) error
+
+// Record implements AuditSink.
+func (f AuditSinkFunc) Record(record *AuditRecord) error {
+	return f(record)
+}
+
+// NewJSONAuditSink returns a sink writing records to w as JSON lines.
+func NewJSONAuditSink(w io.Writer) AuditSink {
+	var mu sync.Mutex
+	encoder := json.NewEncoder(w)
+	return AuditSinkFunc(func(record *AuditRecord) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return encoder.Encode(record)
+	})
+}
+
+// Auditor records the calls modifying resources to a sink, chaining the
+// records by their hashes. It is safe for concurrent use, and may be shared
+// between several callers to get a single trail.
+type Auditor struct {
+	mu       sync.Mutex
+	sink     AuditSink
+	lastHash string
+}
+
+// NewAuditor creates an auditor writing to sink. To continue an existing
+// trail, lastHash is the hash of its last record, and is empty otherwise.
+func NewAuditor(sink AuditSink, lastHash string) *Auditor {
+	return &Auditor{sink: sink, lastHash: lastHash}
+}
+
+// record chains a record and writes it to the sink.
+func (auditor *Auditor) record(record *AuditRecord) error {
+	auditor.mu.Lock()
+	defer auditor.mu.Unlock()
+
+	record.PrevHash = auditor.lastHash
+	record.Hash = record.computeHash()
+	if err := auditor.sink.Record(record); err != nil {
+		return err
+	}
+	auditor.lastHash = record.Hash
+	return nil
+}
+
+// VerifyAuditTrail checks that records form an unaltered chain, starting
+// after the record whose hash is prevHash, empty for a whole trail.
+func VerifyAuditTrail(records []*AuditRecord, prevHash string) error {
+	for i, record := range records {
+		if record.PrevHash != prevHash {
+			return fmt.Errorf("Audit record %d doesn't follow the previous one", i)
+		}
+		if record.computeHash() != record.Hash {
+			return fmt.Errorf("Audit record %d was altered", i)
+		}
+		prevHash = record.Hash
+	}
+	return nil
+}
+
+// audit records a call modifying resources, if the caller has an auditor.
+// Failures of the sink are logged, as the call was already performed.
+func (caller *Caller) audit(method, path string, params []byte, result *http.Response, err error, start time.Time) {
+	if caller.Audit == nil || isSafeMethod(method) {
+		return
+	}
+
+	record := &AuditRecord{
+		Time:   start.UTC(),
+		Method: method,
+		Path:   path,
+		DryRun: caller.DryRun,
+	}
+	if len(params) > 0 {
+		record.Body = caller.redactBody(params)
+	}
+	if result != nil {
+		record.StatusCode = result.StatusCode
+		record.QueryID = result.Header.Get("X-Ovh-QueryID")
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	if err := caller.Audit.record(record); err != nil && caller.Logger != nil {
+		caller.Logger.Printf("govh: audit of %s %s failed: %s", method, path, err)
+	}
+}
@@ -0,0 +1,62 @@
+package govh
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAudit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			fmt.Fprint(w, time.Now().Unix())
+			return
+		}
+		w.Header().Set("X-Ovh-QueryID", "EU.ext-1.abc")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var trail bytes.Buffer
+	c, err := NewClient(server.URL, WithoutTimeSync(), WithCredentials("ak", "secretsecret", "ck"), WithAudit(NewJSONAuditSink(&trail)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.Get("/domain/zone", nil)
+	c.Post("/domain/zone/example.com/record", map[string]string{"target": "192.0.2.1", "password": "secretsecret"}, nil)
+	c.Delete("/domain/zone/example.com/record/42", nil)
+
+	var records []*AuditRecord
+	decoder := json.NewDecoder(&trail)
+	for decoder.More() {
+		record := &AuditRecord{}
+		if err := decoder.Decode(record); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	if records[0].Method != "POST" || records[0].Path != "/domain/zone/example.com/record" || records[0].StatusCode != 200 ||
+		records[0].QueryID != "EU.ext-1.abc" || records[0].Body != `{"password":"REDACTED","target":"192.0.2.1"}` {
+		t.Fatalf("unexpected record %+v", records[0])
+	}
+	if err := VerifyAuditTrail(records, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	records[0].Path = "/domain/zone/example.org/record"
+	if err := VerifyAuditTrail(records, ""); err == nil {
+		t.Fatal("expected an altered record to be detected")
+	}
+	if err := VerifyAuditTrail(records[1:], ""); err == nil {
+		t.Fatal("expected a removed record to be detected")
+	}
+}
@@ -0,0 +1,159 @@
+package govh
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AuthProvider authenticates the requests sent to the API.
+// Implementations must be safe for concurrent use.
+type AuthProvider interface {
+	// SignRequest adds authentication to request, whose body is given.
+	// Now is the current time on the API clock.
+	SignRequest(request *http.Request, body []byte, now time.Time) error
+}
+
+// ClockSensitive is implemented by providers whose authentication depends on
+// the API clock. Callers synchronize their time before using them, and again
+// when the API rejects a timestamp.
+type ClockSensitive interface {
+	ClockSensitive() bool
+}
+
+// SecretHolder is implemented by providers holding secrets, which callers
+// redact from their logs and audit records.
+type SecretHolder interface {
+	Secrets() []string
+}
+
+// ConsumerKeyOmitter is implemented by providers signing requests with a
+// consumer key, to sign the calls made with WithoutConsumerKey. Such calls
+// fail with other providers.
+type ConsumerKeyOmitter interface {
+	// WithoutConsumerKey returns a provider signing requests like this one,
+	// without the consumer key.
+	WithoutConsumerKey() AuthProvider
+}
+
+// SignatureAuth is the classic authentication scheme of the OVH API, signing
+// requests with an application key, an application secret and a consumer key.
+type SignatureAuth struct {
+	ApplicationKey    string
+	ApplicationSecret string
+	// The X-Ovh-Consumer header is omitted when empty.
+	ConsumerKey string
+}
+
+// SignRequest implements AuthProvider.
+func (auth *SignatureAuth) SignRequest(request *http.Request, body []byte, now time.Time) error {
+	timestamp := now.Unix()
+
+	if auth.ConsumerKey != "" {
+		request.Header.Set("X-Ovh-Consumer", auth.ConsumerKey)
+	}
+	request.Header.Set("X-Ovh-Application", auth.ApplicationKey)
+	request.Header.Set("X-Ovh-Timestamp", strconv.FormatInt(timestamp, 10))
+	request.Header.Set("X-Ovh-Signature", Sign(auth.ApplicationSecret, auth.ConsumerKey, request.Method, request.URL.String(), string(body), timestamp))
+
+	return nil
+}
+
+// ClockSensitive implements ClockSensitive.
+func (auth *SignatureAuth) ClockSensitive() bool {
+	return true
+}
+
+// Secrets implements SecretHolder.
+func (auth *SignatureAuth) Secrets() []string {
+	return []string{auth.ApplicationSecret, auth.ConsumerKey}
+}
+
+// WithoutConsumerKey implements ConsumerKeyOmitter.
+func (auth *SignatureAuth) WithoutConsumerKey() AuthProvider {
+	return &SignatureAuth{ApplicationKey: auth.ApplicationKey, ApplicationSecret: auth.ApplicationSecret}
+}
+
+// NoAuth sends requests without authentication, for routes which don't
+// require it. Only the application key is sent, if set.
+type NoAuth struct {
+	ApplicationKey string
+}
+
+// SignRequest implements AuthProvider.
+func (auth *NoAuth) SignRequest(request *http.Request, body []byte, now time.Time) error {
+	if auth.ApplicationKey != "" {
+		request.Header.Set("X-Ovh-Application", auth.ApplicationKey)
+	}
+	return nil
+}
+
+// authProvider returns the provider authenticating a call.
+func (caller *Caller) authProvider(options *callOptions) AuthProvider {
+	if options.unauthenticated {
+		return &NoAuth{ApplicationKey: caller.ApplicationKey}
+	}
+
+	if caller.Auth != nil {
+		if !options.withoutConsumerKey {
+			return caller.Auth
+		}
+		if omitter, ok := caller.Auth.(ConsumerKeyOmitter); ok {
+			return omitter.WithoutConsumerKey()
+		}
+		return &failingAuth{err: fmt.Errorf("Calls without consumer key are not supported by %T", caller.Auth)}
+	}
+
+	consumerKey, _ := caller.credentials()
+	if options.withoutConsumerKey {
+		consumerKey = ""
+	}
+	return &SignatureAuth{
+		ApplicationKey:    caller.ApplicationKey,
+		ApplicationSecret: caller.ApplicationSecret,
+		ConsumerKey:       consumerKey,
+	}
+}
+
+// authKey identifies the credentials and the authentication mode of a call,
+// so that responses cached or shared for a call are never served to a call
+// authenticated otherwise. Providers are told apart by their identity.
+func (caller *Caller) authKey(options *callOptions) string {
+	consumerKey, _ := caller.credentials()
+	parts := []string{caller.ApplicationKey, consumerKey}
+	if caller.Auth != nil {
+		parts = append(parts, fmt.Sprintf("%T %p", caller.Auth, caller.Auth))
+	}
+	switch {
+	case options.unauthenticated:
+		parts = append(parts, "unauthenticated")
+	case options.withoutConsumerKey:
+		parts = append(parts, "withoutConsumerKey")
+	}
+	return strings.Join(parts, "\n")
+}
+
+// failingAuth fails to sign requests, for calls which the provider of the
+// caller can't authenticate.
+type failingAuth struct {
+	err error
+}
+
+// SignRequest implements AuthProvider.
+func (auth *failingAuth) SignRequest(request *http.Request, body []byte, now time.Time) error {
+	return auth.err
+}
+
+// isClockSensitive tells whether provider depends on the API clock.
+func isClockSensitive(provider AuthProvider) bool {
+	sensitive, ok := provider.(ClockSensitive)
+	return ok && sensitive.ClockSensitive()
+}
+
+// now returns the current time on the API clock.
+func (caller *Caller) now() time.Time {
+	_, delay := caller.credentials()
+	return time.Now().Add(delay)
+}
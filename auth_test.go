@@ -0,0 +1,95 @@
+package govh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type gatewayAuth struct {
+	token string
+}
+
+func (auth *gatewayAuth) SignRequest(request *http.Request, body []byte, now time.Time) error {
+	request.Header.Set("X-Gateway-Token", auth.token)
+	return nil
+}
+
+func TestAuthProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			t.Error("custom providers should not synchronize time")
+		}
+		if r.Header.Get("X-Gateway-Token") != "secret" || r.Header.Get("X-Ovh-Signature") != "" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"message":"Forbidden"}`))
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithAuthProvider(&gatewayAuth{token: "secret"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSignatureAuth(t *testing.T) {
+	auth := &SignatureAuth{ApplicationKey: "ak", ApplicationSecret: "as"}
+	request := httptest.NewRequest("GET", "https://eu.api.ovh.com/1.0/newAccount/rules", nil)
+	now := time.Unix(1700000000, 0)
+
+	if err := auth.SignRequest(request, nil, now); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := request.Header["X-Ovh-Consumer"]; ok {
+		t.Fatal("consumer key header should be omitted")
+	}
+	if request.Header.Get("X-Ovh-Signature") != Sign("as", "", "GET", "https://eu.api.ovh.com/1.0/newAccount/rules", "", 1700000000) {
+		t.Fatal("unexpected signature")
+	}
+	if !isClockSensitive(auth) || isClockSensitive(&NoAuth{}) {
+		t.Fatal("only signatures depend on the API clock")
+	}
+}
+
+func TestAuthProviderWithoutConsumerKey(t *testing.T) {
+	var consumers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			fmt.Fprint(w, time.Now().Unix())
+			return
+		}
+		consumers = append(consumers, r.Header.Get("X-Ovh-Consumer"))
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithCredentialsProvider(StaticCredentials("ak", "as", "ck")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err := c.CallAPIWithContext(ctx, "/newAccount/rules", "GET", nil, nil, WithoutConsumerKey()); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CallAPIWithContext(ctx, "/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(consumers) != 2 || consumers[0] != "" || consumers[1] != "ck" {
+		t.Fatalf("unexpected consumer keys %q", consumers)
+	}
+
+	c, err = NewClient(server.URL, WithAuthProvider(&gatewayAuth{token: "secret"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CallAPIWithContext(ctx, "/newAccount/rules", "GET", nil, nil, WithoutConsumerKey()); err == nil {
+		t.Fatal("expected an error for a provider without consumer key support")
+	}
+}
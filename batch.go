@@ -0,0 +1,173 @@
+package govh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// batchSeparator separates identifiers of batch calls.
+const batchSeparator = ","
+
+// batchItem is an element of a batch call response.
+type batchItem struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+	Error json.RawMessage `json:"error"`
+}
+
+// err returns the error reported for the item, if any.
+func (item *batchItem) err() error {
+	if len(item.Error) == 0 || string(item.Error) == "null" {
+		return nil
+	}
+
+	apiError := &ApiOvhError{}
+	var message string
+	if err := json.Unmarshal(item.Error, &message); err == nil {
+		apiError.Message = message
+	} else if err := json.Unmarshal(item.Error, apiError); err != nil {
+		apiError.Message = string(item.Error)
+	}
+	return apiError
+}
+
+// BatchGet fetches several resources in a single call, using the X-Ovh-Batch
+// header. The path format must contain a single %s verb, replaced by the
+// identifiers, e.g. "/domain/zone/%s".
+//
+// Result must be a pointer to a slice, which is filled with one element per
+// identifier, in the order of ids. The returned slice of errors has the same
+// length: it holds the error of each item which failed, and nil elsewhere.
+// The second returned value reports the failure of the whole call.
+func (caller *Caller) BatchGet(ctx context.Context, pathFormat string, ids []string, result interface{}) ([]error, error) {
+	slice := reflect.ValueOf(result)
+	if slice.Kind() != reflect.Ptr || slice.Elem().Kind() != reflect.Slice {
+		return nil, fmt.Errorf("Batch result must be a pointer to a slice, got %T", result)
+	}
+	slice = slice.Elem()
+
+	if len(ids) == 0 {
+		slice.Set(reflect.MakeSlice(slice.Type(), 0, 0))
+		return nil, nil
+	}
+
+	byKey, err := caller.batchItems(ctx, pathFormat, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	values := reflect.MakeSlice(slice.Type(), len(ids), len(ids))
+	errs := make([]error, len(ids))
+	for i, id := range ids {
+		item, ok := byKey[id]
+		if !ok {
+			errs[i] = fmt.Errorf("No result for %q in batch response", id)
+			continue
+		}
+		if errs[i] = item.err(); errs[i] != nil {
+			continue
+		}
+		if len(item.Value) > 0 {
+			errs[i] = caller.decode(item.Value, values.Index(i).Addr().Interface())
+		}
+	}
+	slice.Set(values)
+
+	return errs, nil
+}
+
+// batchItems performs a batch call, and returns its items by identifier.
+func (caller *Caller) batchItems(ctx context.Context, pathFormat string, ids []string) (map[string]*batchItem, error) {
+	escaped := make([]string, len(ids))
+	for i, id := range ids {
+		if strings.Contains(id, batchSeparator) {
+			return nil, fmt.Errorf("Identifier %q can't be batched", id)
+		}
+		escaped[i] = url.PathEscape(id)
+	}
+
+	var items []batchItem
+	path := fmt.Sprintf(pathFormat, strings.Join(escaped, batchSeparator))
+	batchHeader := func(options *callOptions) {
+		options.setHeader("X-Ovh-Batch", batchSeparator)
+	}
+	if err := caller.CallAPIWithContext(ctx, path, "GET", nil, &items, batchHeader); err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]*batchItem, len(items))
+	for i := range items {
+		byKey[items[i].Key] = &items[i]
+	}
+	return byKey, nil
+}
+
+// BatchResult is an element of a batch call response: either the value
+// fetched for an identifier, or the error reported for it.
+type BatchResult[T any] struct {
+	// Identifier of the element.
+	ID string
+	// Value fetched for the identifier, when Err is nil.
+	Value T
+	// Error reported for the identifier, usually an *ApiOvhError.
+	Err error
+}
+
+// UnmarshalJSON decodes an element of a batch call response. The errors of
+// the element are stored in Err instead of being returned, so that a single
+// failed element doesn't fail the decoding of the whole response.
+func (result *BatchResult[T]) UnmarshalJSON(data []byte) error {
+	var item batchItem
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	result.set(&item, json.Unmarshal)
+	return nil
+}
+
+func (result *BatchResult[T]) set(item *batchItem, decode func([]byte, interface{}) error) {
+	result.ID = item.Key
+	if result.Err = item.err(); result.Err != nil {
+		return
+	}
+	if len(item.Value) > 0 {
+		result.Err = decode(item.Value, &result.Value)
+	}
+}
+
+// GetBatch is like Caller.BatchGet, returning one result per identifier, in
+// the order of ids:
+//
+//	zones, err := govh.GetBatch[Zone](ctx, caller, "/domain/zone/%s", names)
+//	for _, zone := range zones {
+//		if zone.Err != nil {
+//			...
+//		}
+//	}
+//
+// The returned error reports the failure of the whole call.
+func GetBatch[T any](ctx context.Context, caller *Caller, pathFormat string, ids []string) ([]BatchResult[T], error) {
+	results := make([]BatchResult[T], len(ids))
+	if len(ids) == 0 {
+		return results, nil
+	}
+
+	byKey, err := caller.batchItems(ctx, pathFormat, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		item, ok := byKey[id]
+		if !ok {
+			results[i] = BatchResult[T]{ID: id, Err: fmt.Errorf("No result for %q in batch response", id)}
+			continue
+		}
+		results[i].set(item, caller.decode)
+	}
+	return results, nil
+}
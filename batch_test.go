@@ -0,0 +1,89 @@
+package govh
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBatchGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Ovh-Batch") != "," {
+			t.Error("missing batch header")
+		}
+		if r.URL.Path != "/domain/zone/a.com,b.com,c.com" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		w.Write([]byte(`[
+			{"key":"c.com","value":{"name":"c.com"},"error":null},
+			{"key":"a.com","value":{"name":"a.com"},"error":null},
+			{"key":"b.com","value":null,"error":"This service does not exist"}
+		]`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+
+	type zone struct{ Name string }
+	var zones []zone
+	errs, err := c.BatchGet(context.Background(), "/domain/zone/%s", []string{"a.com", "b.com", "c.com"}, &zones)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(zones) != 3 || zones[0].Name != "a.com" || zones[2].Name != "c.com" {
+		t.Fatalf("unexpected zones %+v", zones)
+	}
+	if errs[0] != nil || errs[2] != nil {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	if apiErr, ok := errs[1].(*ApiOvhError); !ok || apiErr.Message != "This service does not exist" {
+		t.Fatalf("unexpected error for b.com: %v", errs[1])
+	}
+}
+
+func TestGetBatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"key":"2","value":{"name":"b.com"},"error":null},
+			{"key":"1","value":{"name":"a.com"},"error":null},
+			{"key":"3","value":{"name":42},"error":null},
+			{"key":"4","value":null,"error":{"message":"This service does not exist"}}
+		]`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+
+	type zone struct{ Name string }
+	results, err := GetBatch[zone](context.Background(), c, "/domain/zone/%s", []string{"1", "2", "3", "4", "5"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 5 || results[0].ID != "1" || results[0].Value.Name != "a.com" || results[1].Value.Name != "b.com" {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	if results[0].Err != nil || results[1].Err != nil {
+		t.Fatalf("unexpected errors %v, %v", results[0].Err, results[1].Err)
+	}
+	if results[2].Err == nil {
+		t.Fatal("expected a decoding error for 3")
+	}
+	if apiErr, ok := results[3].Err.(*ApiOvhError); !ok || apiErr.Message != "This service does not exist" {
+		t.Fatalf("unexpected error for 4: %v", results[3].Err)
+	}
+	if results[4].ID != "5" || results[4].Err == nil {
+		t.Fatalf("expected a missing result error for 5, got %+v", results[4])
+	}
+
+	var decoded []BatchResult[zone]
+	if err := json.Unmarshal([]byte(`[{"key":"1","value":{"name":"a.com"}},{"key":"2","value":{"name":false}}]`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != 2 || decoded[0].Value.Name != "a.com" || decoded[1].Err == nil {
+		t.Fatalf("unexpected decoded results %+v", decoded)
+	}
+}
@@ -0,0 +1,51 @@
+package govh
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+)
+
+// RawBody is a request body sent as is, instead of being encoded as JSON,
+// e.g. to upload a document. The signature is computed over Data.
+type RawBody struct {
+	// Content-Type header of the request. Defaults to application/octet-stream.
+	ContentType string
+	// Bytes sent as request body.
+	Data []byte
+}
+
+// NewRawBody reads r to build a raw body.
+func NewRawBody(contentType string, r io.Reader) (*RawBody, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RawBody{ContentType: contentType, Data: data}, nil
+}
+
+// encodeBody returns the bytes and content type to send for body.
+func encodeBody(body interface{}) ([]byte, string, error) {
+	switch b := body.(type) {
+	case nil:
+		return nil, "application/json", nil
+	case *RawBody:
+		return b.Data, b.contentType(), nil
+	case RawBody:
+		return b.Data, b.contentType(), nil
+	}
+
+	params, err := json.Marshal(body)
+	if err != nil {
+		return nil, "", err
+	}
+	return params, "application/json", nil
+}
+
+func (body *RawBody) contentType() string {
+	if body.ContentType == "" {
+		return "application/octet-stream"
+	}
+	return body.ContentType
+}
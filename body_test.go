@@ -0,0 +1,41 @@
+package govh
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestRawBody(t *testing.T) {
+	zone := "$TTL 3600\nwww IN A 192.0.2.1\n"
+	c := &Caller{ApplicationSecret: "as", ConsumerKey: "ck"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != zone {
+			t.Errorf("unexpected body %q", body)
+		}
+		if r.Header.Get("Content-Type") != "text/plain" {
+			t.Errorf("unexpected content type %q", r.Header.Get("Content-Type"))
+		}
+
+		timestamp, _ := strconv.ParseInt(r.Header.Get("X-Ovh-Timestamp"), 10, 64)
+		if r.Header.Get("X-Ovh-Signature") != c.getSignature("ck", r.Method, c.URL+r.URL.RequestURI(), zone, timestamp) {
+			t.Error("signature doesn't match the raw body")
+		}
+	}))
+	defer server.Close()
+	c.URL = server.URL
+
+	body, err := NewRawBody("text/plain", strings.NewReader(zone))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.PostWithContext(context.Background(), "/upload", body, nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,142 @@
+package govh
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Cache stores responses of GET calls along with their validators, so that
+// they can be replayed when the API answers 304 Not Modified to a
+// conditional request.
+type Cache interface {
+	// Get returns the response stored for key, if any.
+	Get(key string) (*CachedResponse, bool)
+	// Set stores a response for key.
+	Set(key string, response *CachedResponse)
+}
+
+// CachedResponse is a response stored in a Cache.
+type CachedResponse struct {
+	// Validators sent back in If-None-Match and If-Modified-Since headers.
+	ETag         string
+	LastModified string
+	// Response headers and body.
+	Header http.Header
+	Body   []byte
+	// When the response was received.
+	StoredAt time.Time
+}
+
+// MemoryCache is a Cache keeping the most recently used responses in memory.
+// It is safe for concurrent use.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List
+}
+
+type memoryCacheEntry struct {
+	key      string
+	response *CachedResponse
+}
+
+// NewMemoryCache creates an in-memory cache holding at most maxEntries
+// responses. There is no limit if maxEntries is zero.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// Get implements Cache.
+func (cache *MemoryCache) Get(key string) (*CachedResponse, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	elem, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	cache.lru.MoveToFront(elem)
+	return elem.Value.(*memoryCacheEntry).response, true
+}
+
+// Set implements Cache.
+func (cache *MemoryCache) Set(key string, response *CachedResponse) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if elem, ok := cache.entries[key]; ok {
+		elem.Value.(*memoryCacheEntry).response = response
+		cache.lru.MoveToFront(elem)
+		return
+	}
+
+	cache.entries[key] = cache.lru.PushFront(&memoryCacheEntry{key: key, response: response})
+	if cache.maxEntries > 0 && cache.lru.Len() > cache.maxEntries {
+		oldest := cache.lru.Back()
+		cache.lru.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// lookupCache returns the cache key of a call, and the response cached for it.
+// Conditional headers are added to the call when a response is cached.
+// The key is empty when the call can't be cached.
+func (caller *Caller) lookupCache(options *callOptions, method, completeURL string, stream bool) (string, *CachedResponse) {
+	if caller.Cache == nil || method != "GET" || stream {
+		return "", nil
+	}
+
+	// Responses depend on the credentials: callers sharing a cache with
+	// other consumer keys or providers must not see each other's responses.
+	key := caller.authKey(options) + "\n" + completeURL
+
+	cached, ok := caller.Cache.Get(key)
+	if !ok {
+		return key, nil
+	}
+
+	if cached.ETag != "" {
+		options.setHeader("If-None-Match", cached.ETag)
+	}
+	if cached.LastModified != "" {
+		options.setHeader("If-Modified-Since", cached.LastModified)
+	}
+	return key, cached
+}
+
+// updateCache stores a successful response in the cache, or replaces a 304
+// Not Modified response by the cached one.
+func (caller *Caller) updateCache(key string, cached *CachedResponse, result *http.Response, body []byte) (*http.Response, []byte) {
+	if result.StatusCode == http.StatusNotModified && cached != nil {
+		replayed := *result
+		replayed.StatusCode = http.StatusOK
+		replayed.Status = "200 OK"
+		replayed.Header = cached.Header.Clone()
+		return &replayed, cached.Body
+	}
+
+	if result.StatusCode != http.StatusOK {
+		return result, body
+	}
+
+	etag, lastModified := result.Header.Get("ETag"), result.Header.Get("Last-Modified")
+	if etag == "" && lastModified == "" {
+		return result, body
+	}
+
+	caller.Cache.Set(key, &CachedResponse{
+		ETag:         etag,
+		LastModified: lastModified,
+		Header:       result.Header.Clone(),
+		Body:         body,
+		StoredAt:     time.Now(),
+	})
+	return result, body
+}
@@ -0,0 +1,74 @@
+package govh
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCache(t *testing.T) {
+	var calls, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"name":"ovh"}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, Cache: NewMemoryCache(10)}
+
+	for i := 0; i < 3; i++ {
+		var me struct{ Name string }
+		if err := c.CallAPI("/me", "GET", nil, &me); err != nil {
+			t.Fatal(err)
+		}
+		if me.Name != "ovh" {
+			t.Fatalf("unexpected response on call %d: %+v", i, me)
+		}
+	}
+	if calls != 3 || notModified != 2 {
+		t.Fatalf("expected 2 revalidations over 3 calls, got %d/%d", notModified, calls)
+	}
+
+	// Another consumer key doesn't share cached responses.
+	if err := c.WithConsumerKey("other").CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if notModified != 2 {
+		t.Fatal("cached response was shared with another consumer key")
+	}
+
+	// Neither do other providers, nor unauthenticated calls.
+	tenant := c.Clone()
+	tenant.Auth = &gatewayAuth{token: "tenant"}
+	if err := tenant.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CallAPIWithContext(context.Background(), "/me", "GET", nil, nil, Unauthenticated()); err != nil {
+		t.Fatal(err)
+	}
+	if notModified != 2 {
+		t.Fatal("cached response was shared with another provider")
+	}
+}
+
+func TestMemoryCacheEviction(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", &CachedResponse{})
+	cache.Set("b", &CachedResponse{})
+	cache.Get("a")
+	cache.Set("c", &CachedResponse{})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Fatal("least recently used entry should be evicted")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("recently used entry should be kept")
+	}
+}
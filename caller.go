@@ -3,27 +3,21 @@
 package govh
 
 import (
-	"bytes"
-	"crypto/sha1"
-	"encoding/hex"
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 )
 
-// API URLs
-var APIURL = map[string]string{
-	"ovh-eu":   "https://api.ovh.com/1.0",
-	"ovh-ca":   "https://ca.api.ovh.com/1.0",
-	"runabove": "https://api.runabove.com/1.0",
-}
-
 // Caller is a struct representing a caller to OVH API.
+//
+// A Caller is safe for concurrent use by multiple goroutines. Its exported
+// fields must be set before the first call and not modified afterwards,
+// except for the consumer key, which can be changed with SetConsumerKey.
 type Caller struct {
 	// Your application key, given when you registered your application inside OVH.
 	ApplicationKey string
@@ -33,52 +27,136 @@ type Caller struct {
 	ConsumerKey string
 	// OVH API Url.
 	URL string
+	// Authentication of requests. When nil, requests are signed with
+	// ApplicationKey, ApplicationSecret and ConsumerKey.
+	Auth AuthProvider
+	// User-Agent header sent with requests. DefaultUserAgent is used when empty.
+	UserAgent string
+	// HTTP client used to perform requests. http.DefaultClient is used when nil.
+	HTTPClient *http.Client
+	// Logger receiving a line for every request made by CallAPI.
+	// Nothing is logged when nil.
+	Logger Logger
+	// How much is logged about each request, one line by default.
+	LogLevel LogLevel
+	// Collector receiving metrics about every call made by CallAPI.
+	Metrics MetricsCollector
+	// Tracer starting a span for every call made by CallAPI.
+	Tracer Tracer
+	// Auditor recording every call modifying resources.
+	Audit *Auditor
+	// Retry policy applied by CallAPI. Retries are disabled when nil.
+	Retry *RetryPolicy
+	// Rate limiter applied to every call made by CallAPI, including retries.
+	// Calls are not limited when nil.
+	RateLimiter *RateLimiter
+	// Circuit breaker rejecting calls with ErrCircuitOpen while the API
+	// looks down. Calls are always attempted when nil.
+	CircuitBreaker *CircuitBreaker
+	// Middlewares called around every request made by CallAPI.
+	Middlewares []Middleware
+	// Cache of GET responses, revalidated with conditional requests.
+	// Responses are not cached when nil.
+	Cache Cache
+	// Coalesce identical concurrent GET calls into a single request, whose
+	// response is shared by all the callers. The request is bound to the
+	// context of the first caller.
+	CoalesceRequests bool
+	// Reject any call other than GET with a ReadOnlyError, before reaching
+	// the API.
+	ReadOnly bool
+	// Intercept calls modifying resources (POST, PUT, DELETE): they are
+	// logged with their signed payload, but not sent to the API, and an
+	// empty success is returned instead. GET calls are performed.
+	DryRun bool
+	// Return ErrDryRun for calls intercepted in dry-run mode, instead of an
+	// empty success.
+	DryRunError bool
+	// Report fields of responses missing from result types with an
+	// UnknownFieldError, e.g. in CI to keep types in sync with the API.
+	StrictDecoding bool
+	// Hook decoding the responses of odd endpoints, see DecodeHook.
+	DecodeHook DecodeHook
+	// Validator checking calls before they are sent.
+	Validator RequestValidator
+	// Disable response compression, e.g. to inspect traffic while debugging.
+	DisableCompression bool
+	// Interval after which time is synchronized again with the API, before
+	// the next signed call. Time is never synchronized again when zero.
+	TimeSyncInterval time.Duration
+	// Transport owned by the caller, once tuned by options
+	transport *http.Transport
+	// GET calls in flight, when coalescing requests
+	flights flightGroup
+	// Protects ConsumerKey, delay and syncedAt once calls are in flight
+	mu sync.RWMutex
 	// Time lag between the caller's clock and the OVH API
 	delay time.Duration
+	// Whether time should be synchronized on first signed call
+	lazySync bool
+	// Timeout of the HTTP client set by WithTimeout, applied by NewClient
+	timeout time.Duration
+	// Last time synchronization with the OVH API
+	syncedAt time.Time
+	// Statistics of the calls made
+	stats callStats
 }
 
 // NewCaller creates a new caller.
+// Endpoint is either a key of APIURL, or the base URL of the API, such as
+// "https://eu.api.ovh.com/v2".
 // It also call Time() to get difference between OVH API time and local time
 func NewCaller(endpoint, applicationKey, applicationSecret, consumerKey string) (*Caller, error) {
-	url, ok := APIURL[endpoint]
-	if !ok {
-		return nil, fmt.Errorf("Invalid endpoint %q", endpoint)
-	}
-
-	caller := &Caller{
-		ApplicationKey:    applicationKey,
-		ApplicationSecret: applicationSecret,
-		ConsumerKey:       consumerKey,
-		URL:               url,
-	}
+	return NewClient(endpoint, WithCredentials(applicationKey, applicationSecret, consumerKey))
+}
 
-	ovhTime, err := caller.Time()
-	if err != nil {
-		return nil, err
-	}
+// NewCallerWithContext creates a new caller, using ctx for the initial
+// time synchronization.
+func NewCallerWithContext(ctx context.Context, endpoint, applicationKey, applicationSecret, consumerKey string) (*Caller, error) {
+	return NewClientWithContext(ctx, endpoint, WithCredentials(applicationKey, applicationSecret, consumerKey))
+}
 
-	caller.delay = time.Since(*ovhTime)
+// NewLazyCaller creates a new caller without calling the API.
+// Time is synchronized on the first signed call, or by calling SyncTime.
+func NewLazyCaller(endpoint, applicationKey, applicationSecret, consumerKey string) (*Caller, error) {
+	return NewClient(endpoint, WithCredentials(applicationKey, applicationSecret, consumerKey), WithoutTimeSync())
+}
 
-	return caller, nil
+func (caller *Caller) httpClient() *http.Client {
+	if caller.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return caller.HTTPClient
 }
 
 // Ping performs a ping to OVH API.
 // In fact, ping is just a /auth/time call, in order to check if API is up.
 func (caller *Caller) Ping() error {
-	_, err := caller.Time()
+	return caller.PingWithContext(context.Background())
+}
+
+// PingWithContext is like Ping, but the request is bound to ctx.
+func (caller *Caller) PingWithContext(ctx context.Context) error {
+	_, err := caller.TimeWithContext(ctx)
 	return err
 }
 
 // Time returns time from the OVH API, by asking GET /auth/time.
 // Time is used to sign requests and to make all calls to API.
 func (caller *Caller) Time() (*time.Time, error) {
-	request, err := http.NewRequest("GET", fmt.Sprintf("%s/auth/time", caller.URL), nil)
+	return caller.TimeWithContext(context.Background())
+}
+
+// TimeWithContext is like Time, but the request is bound to ctx.
+func (caller *Caller) TimeWithContext(ctx context.Context) (*time.Time, error) {
+	request, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/auth/time", caller.URL), nil)
 	if err != nil {
 		return nil, err
 	}
 	request.Header.Add("Content-Type", "application/json")
+	request.Header.Set("User-Agent", caller.userAgent())
 
-	result, err := http.DefaultClient.Do(request)
+	result, err := caller.httpClient().Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +181,23 @@ func (caller *Caller) Time() (*time.Time, error) {
 	return &t, nil
 }
 
+// SetConsumerKey changes the consumer key used to sign requests.
+// It can be called while other calls are in flight.
+func (caller *Caller) SetConsumerKey(consumerKey string) {
+	caller.mu.Lock()
+	defer caller.mu.Unlock()
+
+	caller.ConsumerKey = consumerKey
+}
+
+// credentials returns the consumer key and the time lag to use for a call.
+func (caller *Caller) credentials() (string, time.Duration) {
+	caller.mu.RLock()
+	defer caller.mu.RUnlock()
+
+	return caller.ConsumerKey, caller.delay
+}
+
 // GetCKResponse represents the response when asking a new consumerKey.
 type GetCKResponse struct {
 	// Consumer key, which need to be validated by customer.
@@ -121,6 +216,11 @@ type GetCKParams struct {
 	// URL to redirect user after a successful login on GetCKResponse.ValidationUrl.
 	// If set to empty string, user stays on OVH website, but the consumerKey is validated.
 	Redirection string `json:"redirection"`
+	// IP blocks allowed to use the consumerKey, in CIDR notation.
+	// The consumerKey can be used from anywhere if empty.
+	AllowedIPs []string `json:"allowedIPs,omitempty"`
+	// Expiration date of the consumerKey. It never expires if nil.
+	Expiration *time.Time `json:"expiration,omitempty"`
 }
 
 // AccessRule represents a method allowed for a path
@@ -140,121 +240,98 @@ type AccessRule struct {
 // Store the received consumerKey in Caller
 // Consumer key will be defined by the given parameters
 func (caller *Caller) GetConsumerKey(ckParams *GetCKParams) (*GetCKResponse, error) {
-	params, err := json.Marshal(ckParams)
-	if err != nil {
-		return nil, err
-	}
-
-	request, err := http.NewRequest("POST", fmt.Sprintf("%s/auth/credential", caller.URL), bytes.NewReader(params))
-	if err != nil {
-		return nil, err
-	}
-	request.Header.Add("Content-Type", "application/json")
-	request.Header.Add("X-OVH-Application", caller.ApplicationKey)
-
-	result, err := http.DefaultClient.Do(request)
-	if err != nil {
-		return nil, err
-	}
-	defer result.Body.Close()
+	return caller.GetConsumerKeyWithContext(context.Background(), ckParams)
+}
 
-	body, err := ioutil.ReadAll(result.Body)
-	if err != nil {
+// GetConsumerKeyWithContext is like GetConsumerKey, but the request is bound
+// to ctx. It goes through the same pipeline as other calls: a read-only
+// caller refuses it, and a dry-run caller doesn't send it and returns an
+// empty response, leaving the consumer key unchanged.
+func (caller *Caller) GetConsumerKeyWithContext(ctx context.Context, ckParams *GetCKParams) (*GetCKResponse, error) {
+	askCK := new(GetCKResponse)
+	if _, err := caller.CallAPIRaw(ctx, "/auth/credential", "POST", ckParams, askCK, Unauthenticated()); err != nil {
 		return nil, err
 	}
 
-	if result.StatusCode == 200 {
-		askCK := new(GetCKResponse)
-		err := json.Unmarshal(body, askCK)
-		if err != nil {
-			return nil, err
-		}
-
-		caller.ConsumerKey = askCK.ConsumerKey
-
-		return askCK, nil
+	if askCK.ConsumerKey != "" {
+		caller.SetConsumerKey(askCK.ConsumerKey)
 	}
-
-	apiError := &ApiOvhError{Code: result.StatusCode}
-	if err = json.Unmarshal(body, apiError); err != nil {
-		return nil, err
-	}
-
-	return nil, apiError
+	return askCK, nil
 }
 
 // CallAPI makes a new call to the OVH API
 // ApplicationKey, ApplicationSecret and ConsumerKey must be set on Caller
 // Returns the unmarshal json object or error if any occured
 func (caller *Caller) CallAPI(url, method string, body interface{}, typeResult interface{}) error {
-	var params []byte
-	if body != nil {
-		var err error
-		params, err = json.Marshal(body)
-		if err != nil {
-			return err
-		}
-	}
+	return caller.CallAPIWithContext(context.Background(), url, method, body, typeResult)
+}
 
-	completeURL := caller.URL + url
-	request, err := http.NewRequest(method, completeURL, bytes.NewReader(params))
-	if err != nil {
-		return err
-	}
+// CallAPIWithContext is like CallAPI, but the request is bound to ctx:
+// cancelling ctx or reaching its deadline aborts the call.
+// Options may be given to customize the call, such as WithQuery.
+func (caller *Caller) CallAPIWithContext(ctx context.Context, url, method string, body interface{}, typeResult interface{}, opts ...CallOption) error {
+	_, err := caller.CallAPIRaw(ctx, url, method, body, typeResult, opts...)
+	return err
+}
 
-	timestamp := time.Now().Add(caller.delay).Unix()
-
-	sig := caller.getSignature(method, completeURL, string(params), timestamp)
-	for h, v := range map[string]string{
-		"Content-Type":      "application/json",
-		"X-Ovh-Timestamp":   strconv.FormatInt(timestamp, 10),
-		"X-Ovh-Application": caller.ApplicationKey,
-		"X-Ovh-Consumer":    caller.ConsumerKey,
-		"X-Ovh-Signature":   sig,
-	} {
-		request.Header.Add(h, v)
-	}
+// CallAPIRaw is like CallAPIWithContext, but also returns the details of the
+// HTTP response. The response is returned whenever the API answered, even
+// along with an error.
+func (caller *Caller) CallAPIRaw(ctx context.Context, url, method string, body interface{}, typeResult interface{}, opts ...CallOption) (*Response, error) {
+	options := newCallOptions(opts)
 
-	result, err := http.DefaultClient.Do(request)
+	start := time.Now()
+	result, resBody, err := caller.execute(ctx, options, url, method, body, false)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer result.Body.Close()
 
-	resBody, err := ioutil.ReadAll(result.Body)
-	if err != nil {
-		return err
-	}
+	response := newResponse(result, resBody)
+	response.Duration = time.Since(start)
+	response.Attempts = options.attempts
 
 	// >= 200 && < 300
-	if result.StatusCode >= http.StatusOK && result.StatusCode < http.StatusMultipleChoices {
-		if len(resBody) > 0 && typeResult != nil {
-			if err := json.Unmarshal(resBody, &typeResult); err != nil {
-				return err
+	if isSuccess(result.StatusCode) {
+		if typeResult != nil {
+			if err := caller.decode(resBody, typeResult); err != nil {
+				return response, err
 			}
 		}
 
-		return nil
+		return response, nil
+	}
+
+	return response, apiErrorFromResponse(result, resBody)
+}
+
+// CallAPIStream is like CallAPIWithContext, but instead of decoding the
+// response, it returns its body as a stream, for large payloads such as zone
+// exports or bill documents. The caller must close the returned body.
+// An error is returned instead if the API doesn't answer with a success.
+func (caller *Caller) CallAPIStream(ctx context.Context, url, method string, body interface{}, opts ...CallOption) (io.ReadCloser, error) {
+	options := newCallOptions(opts)
+
+	result, resBody, err := caller.execute(ctx, options, url, method, body, true)
+	if err != nil {
+		return nil, err
 	}
 
-	apiError := &ApiOvhError{Code: result.StatusCode}
-	if err = json.Unmarshal(resBody, apiError); err != nil {
-		return err
+	if isSuccess(result.StatusCode) {
+		return result.Body, nil
 	}
 
-	return apiError
+	return nil, apiErrorFromResponse(result, resBody)
+}
+
+// CallAPIUnauthenticated makes a call to a route of the OVH API that doesn't
+// require authentication, such as /auth/time or the API schemas.
+// The request is not signed, only the application key is sent if set.
+func (caller *Caller) CallAPIUnauthenticated(url, method string, body interface{}, typeResult interface{}) error {
+	return caller.CallAPIUnauthenticatedWithContext(context.Background(), url, method, body, typeResult)
 }
 
-func (caller *Caller) getSignature(method, url, body string, timestamp int64) string {
-	h := sha1.New()
-	sig := strings.Join([]string{
-		caller.ApplicationSecret,
-		caller.ConsumerKey,
-		method,
-		url,
-		body,
-		strconv.FormatInt(timestamp, 10),
-	}, "+")
-	io.WriteString(h, sig)
-	return "$1$" + hex.EncodeToString(h.Sum(nil))
+// CallAPIUnauthenticatedWithContext is like CallAPIUnauthenticated, but the
+// request is bound to ctx.
+func (caller *Caller) CallAPIUnauthenticatedWithContext(ctx context.Context, url, method string, body interface{}, typeResult interface{}, opts ...CallOption) error {
+	return caller.CallAPIWithContext(ctx, url, method, body, typeResult, append(opts, Unauthenticated())...)
 }
@@ -1,27 +1,96 @@
 package govh
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
 
-var caller *Caller
+// newFakeAPI starts a server answering like the OVH API to the calls of the
+// tests below, checking the signature of requests made with "ak", "as" and
+// "ck".
+func newFakeAPI(t *testing.T) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /auth/time":
+			w.Write([]byte(strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)))
+
+		case "POST /auth/credential":
+			if r.Header.Get("X-Ovh-Application") != "ak" || r.Header.Get("X-Ovh-Signature") != "" {
+				t.Errorf("unexpected credential request headers %v", r.Header)
+			}
+			w.Write([]byte(`{"consumerKey":"newck","state":"pendingValidation","validationUrl":"https://eu.api.ovh.com/auth/?credentialToken=token"}`))
+
+		case "GET /me":
+			timestamp, _ := strconv.ParseInt(r.Header.Get("X-Ovh-Timestamp"), 10, 64)
+			signature := Sign("as", r.Header.Get("X-Ovh-Consumer"), "GET", server.URL+"/me", "", timestamp)
+			if r.Header.Get("X-Ovh-Application") != "ak" || r.Header.Get("X-Ovh-Signature") != signature {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"errorCode":"INVALID_SIGNATURE","message":"Invalid signature"}`))
+				return
+			}
+			if r.Header.Get("X-Ovh-Consumer") != "ck" {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"errorCode":"INVALID_CREDENTIAL","message":"This credential is not valid"}`))
+				return
+			}
+			w.Write([]byte(`{"name":"Doe","firstname":"John"}`))
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"Got an invalid (or empty) URL"}`))
+		}
+	}))
+	return server
+}
 
 func TestNewCaller(t *testing.T) {
-	var err error
-	caller, err = NewCaller("ovh-eu", "INSERT AK", "INSERT AS", "")
+	server := newFakeAPI(t)
+	defer server.Close()
 
+	caller, err := NewCaller(server.URL, "ak", "as", "")
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(caller.delay)
+	if caller.delay > -59*time.Second || caller.delay < -61*time.Second {
+		t.Fatalf("unexpected delay %s", caller.delay)
+	}
 }
 
 func TestPing(t *testing.T) {
+	server := newFakeAPI(t)
+
+	caller, err := NewLazyCaller(server.URL, "ak", "as", "")
+	if err != nil {
+		t.Fatal(err)
+	}
 	if err := caller.Ping(); err != nil {
 		t.Fatal(err)
 	}
+
+	server.Close()
+	if err := caller.Ping(); err == nil {
+		t.Fatal("expected an error once the API is down")
+	}
 }
 
 func TestGetConsumerKey(t *testing.T) {
+	server := newFakeAPI(t)
+	defer server.Close()
+
+	caller, err := NewLazyCaller(server.URL, "ak", "as", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	ck, err := caller.GetConsumerKey(&GetCKParams{
 		AccessRules: []*AccessRule{
 			&AccessRule{
@@ -35,11 +104,20 @@ func TestGetConsumerKey(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Log(ck.ValidationUrl, ck.ConsumerKey)
+	if ck.ConsumerKey != "newck" || ck.ValidationURL == "" || caller.ConsumerKey != "newck" {
+		t.Fatalf("unexpected consumer key %+v", ck)
+	}
 }
 
 func TestCallApi(t *testing.T) {
-	caller.ConsumerKey = "INSERT VALIDATED CK"
+	server := newFakeAPI(t)
+	defer server.Close()
+
+	caller, err := NewCaller(server.URL, "ak", "as", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	caller.ConsumerKey = "ck"
 
 	type Me struct {
 		Name      string
@@ -48,11 +126,169 @@ func TestCallApi(t *testing.T) {
 
 	me := &Me{}
 
-	err := caller.CallApi("/me", "GET", nil, me)
+	err = caller.CallAPI("/me", "GET", nil, me)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if me.Firstname != "John" || me.Name != "Doe" {
+		t.Fatalf("unexpected result %+v", me)
+	}
+
+	caller.SetConsumerKey("unvalidated")
+	if err := caller.CallAPI("/me", "GET", nil, me); !IsInvalidCredential(err) {
+		t.Fatalf("expected an invalid credential error, got %v", err)
+	}
+}
+
+func TestCallAPIWithContextCancel(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := c.CallAPIWithContext(ctx, "/me", "GET", nil, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	if err := c.PingWithContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded on ping, got %v", err)
+	}
+}
+
+func TestCallAPIRaw(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ovh-QueryID", "EU.ext-1.5e2f.1234")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"The requested object (id = 42) does not exist"}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+
+	response, err := c.CallAPIRaw(context.Background(), "/me/bill/42", "GET", nil, nil)
+	if _, ok := err.(*ApiOvhError); !ok {
+		t.Fatalf("expected an API error, got %v", err)
+	}
+	if response == nil || response.StatusCode != http.StatusNotFound || response.QueryID != "EU.ext-1.5e2f.1234" {
+		t.Fatalf("unexpected response: %+v", response)
+	}
+}
+
+func TestCallAPIUnauthenticated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			t.Error("unauthenticated calls should not synchronize time")
+		}
+		for _, h := range []string{"X-Ovh-Signature", "X-Ovh-Timestamp", "X-Ovh-Consumer"} {
+			if r.Header.Get(h) != "" {
+				t.Errorf("unexpected header %s", h)
+			}
+		}
+		if r.Header.Get("X-Ovh-Application") != "ak" {
+			t.Error("application key should be sent")
+		}
+		w.Write([]byte(`{"apis":[]}`))
+	}))
+	defer server.Close()
+
+	c, err := NewLazyCaller(server.URL, "ak", "as", "ck")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var description struct{ APIs []interface{} }
+	if err := c.CallAPIUnauthenticated("/", "GET", nil, &description); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCallAPIWithoutConsumerKey(t *testing.T) {
+	c := &Caller{ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "ck"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.Header["X-Ovh-Consumer"]; ok {
+			t.Error("consumer key should not be sent")
+		}
+
+		timestamp, _ := strconv.ParseInt(r.Header.Get("X-Ovh-Timestamp"), 10, 64)
+		if r.Header.Get("X-Ovh-Signature") != c.getSignature("", r.Method, c.URL+r.URL.RequestURI(), "", timestamp) {
+			t.Error("signature should not include the consumer key")
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+	c.URL = server.URL
 
+	if err := c.CallAPIWithContext(context.Background(), "/newAccount/rules", "GET", nil, nil, WithoutConsumerKey()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCallAPIStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/domain/zone/missing.com/export" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"This service does not exist"}`))
+			return
+		}
+		w.Write([]byte(`"$TTL 3600\n@ IN SOA dns.ovh.net. tech.ovh.net. (1 86400 3600 3600000 300)"`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+
+	body, err := c.CallAPIStream(context.Background(), "/domain/zone/example.com/export", "GET", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
+	defer body.Close()
 
-	t.Log(me.Firstname, me.Name)
+	content, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(content), `"$TTL 3600`) {
+		t.Fatalf("unexpected content %q", content)
+	}
+
+	if _, err := c.CallAPIStream(context.Background(), "/domain/zone/missing.com/export", "GET", nil); err == nil {
+		t.Fatal("expected an error for a missing zone")
+	}
+}
+
+func TestCallAPIWithHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Pagination-Size") != "50" {
+			t.Error("custom header is missing")
+		}
+		if values := r.Header.Values("X-Ovh-Consumer"); len(values) != 1 || values[0] != "ck" {
+			t.Errorf("authentication header was overridden: %v", values)
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, ConsumerKey: "ck"}
+
+	err := c.GetWithContext(context.Background(), "/v2/iam/resource", nil,
+		WithHeader("X-Pagination-Size", "50"),
+		WithHeader("X-Ovh-Consumer", "other"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
 }
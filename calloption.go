@@ -0,0 +1,101 @@
+package govh
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// CallOption customizes a single API call.
+type CallOption func(*callOptions)
+
+// callOptions holds the settings of a single API call.
+type callOptions struct {
+	query              url.Values
+	apiVersion         string
+	unauthenticated    bool
+	withoutConsumerKey bool
+	header             http.Header
+	contentType        string
+	route              string
+	// Requests sent for the call, including retries
+	attempts int
+}
+
+func newCallOptions(opts []CallOption) *callOptions {
+	options := &callOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// WithQuery adds query parameters to the called URL.
+// They are encoded and included in the request signature.
+func WithQuery(query url.Values) CallOption {
+	return func(options *callOptions) {
+		if options.query == nil {
+			options.query = url.Values{}
+		}
+		for k, values := range query {
+			for _, v := range values {
+				options.query.Add(k, v)
+			}
+		}
+	}
+}
+
+// Unauthenticated sends the call without signing it, for routes that don't
+// require authentication. Only the application key is sent, if set.
+func Unauthenticated() CallOption {
+	return func(options *callOptions) {
+		options.unauthenticated = true
+	}
+}
+
+// WithoutConsumerKey signs the call with the application credentials only,
+// for routes such as /auth/credential or /newAccount/rules: the
+// X-Ovh-Consumer header is not sent, and the consumer key part of the
+// signature is left empty. With a provider set by WithAuthProvider, the call
+// fails unless the provider implements ConsumerKeyOmitter.
+func WithoutConsumerKey() CallOption {
+	return func(options *callOptions) {
+		options.withoutConsumerKey = true
+	}
+}
+
+// WithHeader adds a header to the request of the call, such as
+// X-Pagination-Size on v2 routes. Authentication headers can't be overridden.
+func WithHeader(key, value string) CallOption {
+	return func(options *callOptions) {
+		options.setHeader(key, value)
+	}
+}
+
+// setHeader adds a header to the request of the call.
+func (options *callOptions) setHeader(key, value string) {
+	if options.header == nil {
+		options.header = http.Header{}
+	}
+	options.header.Set(key, value)
+}
+
+// buildURL returns the complete URL to call for path.
+func (options *callOptions) buildURL(base, path string) string {
+	if options.apiVersion != "" {
+		base = withAPIVersion(base, options.apiVersion)
+	}
+
+	completeURL := base + path
+	if len(options.query) == 0 {
+		return completeURL
+	}
+
+	sep := "?"
+	for i := 0; i < len(path); i++ {
+		if path[i] == '?' {
+			sep = "&"
+			break
+		}
+	}
+	return completeURL + sep + options.query.Encode()
+}
@@ -0,0 +1,110 @@
+package govh
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by callers whose circuit breaker is open, without
+// reaching the API.
+var ErrCircuitOpen = errors.New("govh: circuit breaker open")
+
+// CircuitBreaker makes calls fail fast with ErrCircuitOpen once the API looks
+// down, instead of piling up requests on it. The circuit opens after a number
+// of consecutive failures: network errors and 5xx responses. Once the open
+// timeout has elapsed, the next call probes the API with GET /auth/time, and
+// the circuit is closed again if it answers.
+//
+// It is safe for concurrent use, and may be shared between several callers.
+type CircuitBreaker struct {
+	mu               sync.Mutex
+	failureThreshold int
+	openTimeout      time.Duration
+	failures         int
+	open             bool
+	openedAt         time.Time
+	probing          bool
+}
+
+// NewCircuitBreaker creates a circuit breaker opening after failureThreshold
+// consecutive failures, for at least openTimeout.
+func NewCircuitBreaker(failureThreshold int, openTimeout time.Duration) *CircuitBreaker {
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+
+	return &CircuitBreaker{
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+	}
+}
+
+// Open tells whether the circuit is open, i.e. calls are rejected.
+func (breaker *CircuitBreaker) Open() bool {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	return breaker.open
+}
+
+// allow returns ErrCircuitOpen if a call must be rejected. When the open
+// timeout has elapsed, a single caller runs probe, closing the circuit if it
+// succeeds.
+func (breaker *CircuitBreaker) allow(ctx context.Context, probe func(context.Context) error) error {
+	if breaker == nil {
+		return nil
+	}
+
+	breaker.mu.Lock()
+	if !breaker.open {
+		breaker.mu.Unlock()
+		return nil
+	}
+	if breaker.probing || time.Since(breaker.openedAt) < breaker.openTimeout {
+		breaker.mu.Unlock()
+		return ErrCircuitOpen
+	}
+	breaker.probing = true
+	breaker.mu.Unlock()
+
+	err := probe(ctx)
+
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	breaker.probing = false
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		breaker.openedAt = time.Now()
+		return ErrCircuitOpen
+	}
+	breaker.open = false
+	breaker.failures = 0
+	return nil
+}
+
+// record updates the state of the circuit after a request.
+func (breaker *CircuitBreaker) record(ctx context.Context, result *http.Response, err error) {
+	if breaker == nil || (err != nil && ctx.Err() != nil) {
+		return
+	}
+
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	if err == nil && result.StatusCode < http.StatusInternalServerError {
+		breaker.failures = 0
+		return
+	}
+
+	breaker.failures++
+	if !breaker.open && breaker.failures >= breaker.failureThreshold {
+		breaker.open = true
+		breaker.openedAt = time.Now()
+	}
+}
@@ -0,0 +1,66 @@
+package govh
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var down int32 = 1
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if atomic.LoadInt32(&down) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if r.URL.Path == "/auth/time" {
+			w.Write([]byte(strconv.FormatInt(time.Now().Unix(), 10)))
+			return
+		}
+		w.Write([]byte(`null`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, CircuitBreaker: NewCircuitBreaker(2, 20*time.Millisecond)}
+
+	for i := 0; i < 2; i++ {
+		if err := c.Get("/me", nil); errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("circuit opened after %d failures", i)
+		}
+	}
+	if !c.CircuitBreaker.Open() {
+		t.Fatal("expected the circuit to be open")
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	if err := c.Get("/me", nil); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("expected no request while open, got %d", calls)
+	}
+
+	// The probe fails while the API is down, and the circuit stays open.
+	time.Sleep(30 * time.Millisecond)
+	if err := c.Get("/me", nil); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single probe, got %d requests", calls)
+	}
+
+	atomic.StoreInt32(&down, 0)
+	time.Sleep(30 * time.Millisecond)
+	if err := c.Get("/me", nil); err != nil {
+		t.Fatal(err)
+	}
+	if c.CircuitBreaker.Open() {
+		t.Fatal("expected the circuit to be closed")
+	}
+}
@@ -0,0 +1,35 @@
+package govh
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+// RestrictToIPs restricts the requested consumer key to the given IP
+// addresses or CIDR blocks, e.g. "192.0.2.0/24" or "2001:db8::1".
+func (params *GetCKParams) RestrictToIPs(ips ...string) error {
+	for _, ip := range ips {
+		if _, block, err := net.ParseCIDR(ip); err == nil {
+			params.AllowedIPs = append(params.AllowedIPs, block.String())
+			continue
+		}
+
+		addr := net.ParseIP(ip)
+		if addr == nil {
+			return fmt.Errorf("Invalid IP or CIDR block %q", ip)
+		}
+		if addr.To4() != nil {
+			params.AllowedIPs = append(params.AllowedIPs, addr.String()+"/32")
+		} else {
+			params.AllowedIPs = append(params.AllowedIPs, addr.String()+"/128")
+		}
+	}
+	return nil
+}
+
+// ExpireIn makes the requested consumer key expire after d.
+func (params *GetCKParams) ExpireIn(d time.Duration) {
+	expiration := time.Now().Add(d).UTC().Truncate(time.Second)
+	params.Expiration = &expiration
+}
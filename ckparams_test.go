@@ -0,0 +1,28 @@
+package govh
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetCKParamsRestrictions(t *testing.T) {
+	params := &GetCKParams{AccessRules: ReadOnlyRules("/me")}
+
+	if err := params.RestrictToIPs("192.0.2.10", "198.51.100.7/24", "2001:db8::1"); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"192.0.2.10/32", "198.51.100.0/24", "2001:db8::1/128"}
+	if !reflect.DeepEqual(params.AllowedIPs, expected) {
+		t.Fatalf("unexpected allowed IPs %v", params.AllowedIPs)
+	}
+
+	if err := params.RestrictToIPs("office"); err == nil {
+		t.Fatal("expected an error for an invalid IP")
+	}
+
+	params.ExpireIn(24 * time.Hour)
+	if d := time.Until(*params.Expiration); d < 23*time.Hour || d > 24*time.Hour {
+		t.Fatalf("unexpected expiration %s", params.Expiration)
+	}
+}
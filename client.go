@@ -0,0 +1,282 @@
+package govh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Option configures a Caller built by NewClient.
+type Option func(*Caller) error
+
+// NewClient creates a new caller for the given endpoint, configured by opts:
+//
+//	caller, err := govh.NewClient("ovh-eu",
+//		govh.WithCredentials(ak, as, ck),
+//		govh.WithTimeout(30*time.Second),
+//		govh.WithRetry(govh.DefaultRetryPolicy()),
+//	)
+//
+// Endpoint is either a key of APIURL, or the base URL of the API.
+// Unless WithoutTimeSync is given, it calls the API to synchronize time.
+func NewClient(endpoint string, opts ...Option) (*Caller, error) {
+	return NewClientWithContext(context.Background(), endpoint, opts...)
+}
+
+// NewClientWithContext is like NewClient, using ctx for the initial time
+// synchronization.
+func NewClientWithContext(ctx context.Context, endpoint string, opts ...Option) (*Caller, error) {
+	url, ok := endpointURL(endpoint)
+	if !ok {
+		return nil, fmt.Errorf("Invalid endpoint %q", endpoint)
+	}
+
+	caller := &Caller{URL: url}
+	for _, opt := range opts {
+		if err := opt(caller); err != nil {
+			return nil, err
+		}
+	}
+
+	// The timeout applies to the HTTP client, whichever option set it
+	if caller.timeout > 0 {
+		client := &http.Client{}
+		if caller.HTTPClient != nil {
+			*client = *caller.HTTPClient
+		}
+		client.Timeout = caller.timeout
+		caller.HTTPClient = client
+	}
+
+	if !caller.lazySync && isClockSensitive(caller.authProvider(&callOptions{})) {
+		if err := caller.SyncTimeWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return caller, nil
+}
+
+// WithCredentials sets the application key, application secret and consumer
+// key of the caller. The consumer key may be empty, to ask a new one later
+// with GetConsumerKey. An error is returned for missing application keys or a
+// malformed consumer key.
+func WithCredentials(applicationKey, applicationSecret, consumerKey string) Option {
+	return func(caller *Caller) error {
+		if err := validateCredentials(applicationKey, applicationSecret, consumerKey); err != nil {
+			return err
+		}
+		caller.ApplicationKey = applicationKey
+		caller.ApplicationSecret = applicationSecret
+		caller.ConsumerKey = consumerKey
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to perform requests.
+func WithHTTPClient(client *http.Client) Option {
+	return func(caller *Caller) error {
+		caller.HTTPClient = client
+		caller.transport = nil
+		return nil
+	}
+}
+
+// WithTimeout sets a timeout for each request made to the API. The client
+// given to WithHTTPClient, if any, is copied to set it.
+func WithTimeout(timeout time.Duration) Option {
+	return func(caller *Caller) error {
+		caller.timeout = timeout
+		return nil
+	}
+}
+
+// WithRetry sets the retry policy of the caller.
+func WithRetry(policy *RetryPolicy) Option {
+	return func(caller *Caller) error {
+		caller.Retry = policy
+		return nil
+	}
+}
+
+// WithRateLimit limits the caller to rate requests per second, with bursts of
+// at most burst requests.
+func WithRateLimit(rate float64, burst int) Option {
+	return func(caller *Caller) error {
+		caller.RateLimiter = NewRateLimiter(rate, burst)
+		return nil
+	}
+}
+
+// WithCircuitBreaker makes calls fail fast with ErrCircuitOpen after
+// failureThreshold consecutive failures, for at least openTimeout.
+func WithCircuitBreaker(failureThreshold int, openTimeout time.Duration) Option {
+	return func(caller *Caller) error {
+		caller.CircuitBreaker = NewCircuitBreaker(failureThreshold, openTimeout)
+		return nil
+	}
+}
+
+// WithStrictDecoding reports fields of responses missing from result types
+// with an UnknownFieldError.
+func WithStrictDecoding() Option {
+	return func(caller *Caller) error {
+		caller.StrictDecoding = true
+		return nil
+	}
+}
+
+// WithDecodeHook decodes responses with hook, falling back to JSON decoding
+// when it doesn't handle them. See PlainTextDecodeHook.
+func WithDecodeHook(hook DecodeHook) Option {
+	return func(caller *Caller) error {
+		caller.DecodeHook = hook
+		return nil
+	}
+}
+
+// WithRequestValidator checks calls with validator before sending them.
+func WithRequestValidator(validator RequestValidator) Option {
+	return func(caller *Caller) error {
+		caller.Validator = validator
+		return nil
+	}
+}
+
+// WithLogger sets the logger receiving a line for every request.
+func WithLogger(logger Logger) Option {
+	return func(caller *Caller) error {
+		caller.Logger = logger
+		return nil
+	}
+}
+
+// WithLogLevel sets how much is logged about each request.
+func WithLogLevel(level LogLevel) Option {
+	return func(caller *Caller) error {
+		caller.LogLevel = level
+		return nil
+	}
+}
+
+// WithMetrics reports metrics about every call to collector.
+func WithMetrics(collector MetricsCollector) Option {
+	return func(caller *Caller) error {
+		caller.Metrics = collector
+		return nil
+	}
+}
+
+// WithTracer starts a span with tracer for every call.
+func WithTracer(tracer Tracer) Option {
+	return func(caller *Caller) error {
+		caller.Tracer = tracer
+		return nil
+	}
+}
+
+// WithAudit records the calls modifying resources to sink.
+func WithAudit(sink AuditSink) Option {
+	return func(caller *Caller) error {
+		caller.Audit = NewAuditor(sink, "")
+		return nil
+	}
+}
+
+// WithMiddleware appends middlewares to the caller's chain.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(caller *Caller) error {
+		caller.Use(middlewares...)
+		return nil
+	}
+}
+
+// WithUserAgent identifies the application in the User-Agent header.
+func WithUserAgent(application, version string) Option {
+	return func(caller *Caller) error {
+		caller.SetUserAgent(application, version)
+		return nil
+	}
+}
+
+// WithDefaultAPIVersion makes the caller target the given API version.
+// WithAPIVersion can still be used to target another version for a call.
+func WithDefaultAPIVersion(version string) Option {
+	return func(caller *Caller) error {
+		caller.SetAPIVersion(version)
+		return nil
+	}
+}
+
+// WithoutTimeSync skips time synchronization at construction, so that no call
+// is made to the API. Time is synchronized on the first signed call instead.
+func WithoutTimeSync() Option {
+	return func(caller *Caller) error {
+		caller.lazySync = true
+		return nil
+	}
+}
+
+// WithTimeSyncInterval makes the caller synchronize time again with the API
+// once interval elapsed since the last synchronization.
+func WithTimeSyncInterval(interval time.Duration) Option {
+	return func(caller *Caller) error {
+		caller.TimeSyncInterval = interval
+		return nil
+	}
+}
+
+// WithoutCompression disables response compression.
+func WithoutCompression() Option {
+	return func(caller *Caller) error {
+		caller.DisableCompression = true
+		return nil
+	}
+}
+
+// WithCache caches GET responses in cache, and revalidates them with
+// conditional requests.
+func WithCache(cache Cache) Option {
+	return func(caller *Caller) error {
+		caller.Cache = cache
+		return nil
+	}
+}
+
+// WithRequestCoalescing coalesces identical concurrent GET calls into a
+// single request.
+func WithRequestCoalescing() Option {
+	return func(caller *Caller) error {
+		caller.CoalesceRequests = true
+		return nil
+	}
+}
+
+// WithDryRun intercepts calls modifying resources: they are logged but not
+// sent. If returnError is set, intercepted calls fail with ErrDryRun,
+// otherwise they return an empty success.
+func WithDryRun(returnError bool) Option {
+	return func(caller *Caller) error {
+		caller.DryRun = true
+		caller.DryRunError = returnError
+		return nil
+	}
+}
+
+// WithReadOnly makes the caller reject any call other than GET.
+func WithReadOnly() Option {
+	return func(caller *Caller) error {
+		caller.ReadOnly = true
+		return nil
+	}
+}
+
+// WithAuthProvider sets the provider authenticating requests, instead of the
+// classic signature scheme.
+func WithAuthProvider(provider AuthProvider) Option {
+	return func(caller *Caller) error {
+		caller.Auth = provider
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package govh
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClient(t *testing.T) {
+	var timeCalls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			timeCalls++
+			fmt.Fprint(w, time.Now().Unix())
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	c, err := NewClient(server.URL,
+		WithCredentials("ak", "as", "ck"),
+		WithTimeout(5*time.Second),
+		WithRetry(DefaultRetryPolicy()),
+		WithLogger(log.New(&logs, "", 0)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if timeCalls != 1 {
+		t.Fatal("time should be synchronized at construction")
+	}
+	if c.ApplicationKey != "ak" || c.HTTPClient.Timeout != 5*time.Second || c.Retry == nil {
+		t.Fatalf("options were not applied: %+v", c)
+	}
+
+	if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(logs.String(), "GET "+server.URL+"/me: 200") {
+		t.Fatalf("unexpected logs %q", logs.String())
+	}
+
+	if _, err := NewClient(server.URL, WithoutTimeSync()); err != nil || timeCalls != 1 {
+		t.Fatalf("time should not be synchronized without time sync, got %v", err)
+	}
+
+	// The timeout doesn't depend on the order of options
+	transport := &http.Transport{}
+	c, err = NewClient(server.URL, WithoutTimeSync(), WithTimeout(5*time.Second), WithHTTPClient(&http.Client{Transport: transport}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.HTTPClient.Timeout != 5*time.Second || c.HTTPClient.Transport != transport {
+		t.Fatalf("the timeout was not applied to the given client: %+v", c.HTTPClient)
+	}
+
+	if _, err := NewClient("ovh-mars"); err == nil {
+		t.Fatal("expected an error for an unknown endpoint")
+	}
+}
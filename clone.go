@@ -0,0 +1,52 @@
+package govh
+
+// Clone returns a copy of the caller, sharing its settings, rate limiter, circuit
+// breaker and time synchronization state. Modifying the copy doesn't affect the original.
+func (caller *Caller) Clone() *Caller {
+	consumerKey, delay := caller.credentials()
+
+	caller.mu.RLock()
+	syncedAt := caller.syncedAt
+	caller.mu.RUnlock()
+
+	return &Caller{
+		ApplicationKey:     caller.ApplicationKey,
+		ApplicationSecret:  caller.ApplicationSecret,
+		ConsumerKey:        consumerKey,
+		URL:                caller.URL,
+		Auth:               caller.Auth,
+		UserAgent:          caller.UserAgent,
+		HTTPClient:         caller.HTTPClient,
+		Logger:             caller.Logger,
+		LogLevel:           caller.LogLevel,
+		Metrics:            caller.Metrics,
+		Tracer:             caller.Tracer,
+		Audit:              caller.Audit,
+		Retry:              caller.Retry,
+		RateLimiter:        caller.RateLimiter,
+		CircuitBreaker:     caller.CircuitBreaker,
+		Middlewares:        append([]Middleware(nil), caller.Middlewares...),
+		Cache:              caller.Cache,
+		CoalesceRequests:   caller.CoalesceRequests,
+		ReadOnly:           caller.ReadOnly,
+		DryRun:             caller.DryRun,
+		DryRunError:        caller.DryRunError,
+		StrictDecoding:     caller.StrictDecoding,
+		DecodeHook:         caller.DecodeHook,
+		Validator:          caller.Validator,
+		DisableCompression: caller.DisableCompression,
+		TimeSyncInterval:   caller.TimeSyncInterval,
+		delay:              delay,
+		lazySync:           caller.lazySync,
+		syncedAt:           syncedAt,
+	}
+}
+
+// WithConsumerKey returns a copy of the caller using another consumer key,
+// e.g. to act on behalf of several OVH accounts with the same application.
+// Callers authenticated by an AuthProvider are not affected by the consumer key.
+func (caller *Caller) WithConsumerKey(consumerKey string) *Caller {
+	clone := caller.Clone()
+	clone.ConsumerKey = consumerKey
+	return clone
+}
@@ -0,0 +1,33 @@
+package govh
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithConsumerKey(t *testing.T) {
+	var consumers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		consumers = append(consumers, r.Header.Get("X-Ovh-Consumer"))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, ApplicationKey: "ak", ConsumerKey: "ck1", delay: time.Minute, syncedAt: time.Now()}
+	other := c.WithConsumerKey("ck2")
+
+	if other.delay != c.delay || other.ApplicationKey != "ak" {
+		t.Fatal("clone should share settings and time lag")
+	}
+
+	if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := other.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(consumers) != 2 || consumers[0] != "ck1" || consumers[1] != "ck2" {
+		t.Fatalf("unexpected consumer keys %v", consumers)
+	}
+}
@@ -0,0 +1,718 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/garbage-collector/ovh-go/schema"
+)
+
+// primitiveTypes maps the primitive types of the API to Go types.
+var primitiveTypes = map[string]string{
+	"string":                   "string",
+	"password":                 "string",
+	"text":                     "string",
+	"ip":                       "string",
+	"ipBlock":                  "string",
+	"ipv4":                     "string",
+	"ipv6":                     "string",
+	"ipv4Block":                "string",
+	"ipv6Block":                "string",
+	"ipInterface":              "string",
+	"macAddress":               "string",
+	"phoneNumber":              "string",
+	"internationalPhoneNumber": "string",
+	"uuid":                     "string",
+	"time":                     "string",
+	"duration":                 "string",
+	"long":                     "int64",
+	"int":                      "int64",
+	"double":                   "float64",
+	"boolean":                  "bool",
+	"date":                     "govh.Date",
+	"datetime":                 "govh.DateTime",
+}
+
+// reservedNames can't be used for the types of the generated package.
+var reservedNames = map[string]bool{"Client": true, "New": true}
+
+// reservedArgs can't be used for the arguments of the generated methods.
+var reservedArgs = map[string]bool{
+	"ctx": true, "client": true, "params": true, "body": true, "opts": true,
+	"result": true, "query": true, "err": true, "path": true, "url": true, "fmt": true,
+}
+
+// generator writes the Go code of a client for routes of an API.
+type generator struct {
+	api *schema.API
+	// Name of the API, e.g. "domain", dropped from the model namespaces.
+	root string
+
+	// Go names of the models, and the models used by generated methods.
+	typeNames map[string]string
+	used      map[string]bool
+	// Names of the generated methods and parameter types.
+	methods map[string]bool
+	types   map[string]bool
+
+	imports map[string]bool
+	buf     bytes.Buffer
+}
+
+// generate returns the code of a client for the routes of api starting with
+// one of prefixes, or all of them.
+func generate(api *schema.API, pkg string, prefixes []string, withClient bool) ([]byte, error) {
+	g := &generator{
+		api:       api,
+		root:      strings.Trim(api.ResourcePath, "/"),
+		typeNames: map[string]string{},
+		used:      map[string]bool{},
+		methods:   map[string]bool{},
+		types:     map[string]bool{},
+		imports:   map[string]bool{},
+	}
+	g.nameModels()
+
+	var methods bytes.Buffer
+	routes := append([]*schema.Route(nil), api.Routes...)
+	sort.Slice(routes, func(i, j int) bool { return routes[i].Path < routes[j].Path })
+	for _, route := range routes {
+		if !hasPrefix(route.Path, prefixes) {
+			continue
+		}
+		for _, operation := range route.Operations {
+			if operation.APIStatus != nil && operation.APIStatus.Value == "DELETED" {
+				continue
+			}
+			g.buf.Reset()
+			g.method(route, operation)
+			methods.Write(g.buf.Bytes())
+		}
+	}
+
+	var models bytes.Buffer
+	for done := map[string]bool{}; len(done) < len(g.used); {
+		var names []string
+		for name := range g.used {
+			if !done[name] {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			done[name] = true
+			g.buf.Reset()
+			g.model(name, g.api.Models[name])
+			models.Write(g.buf.Bytes())
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by govh-gen from the %s API. DO NOT EDIT.\n\n", api.ResourcePath)
+	fmt.Fprintf(&out, "package %s\n\n", pkg)
+	if withClient || methods.Len() > 0 {
+		g.imports["github.com/garbage-collector/ovh-go"] = true
+	}
+	g.writeImports(&out)
+	if withClient {
+		fmt.Fprintf(&out, "// Client calls the %s routes.\n", api.ResourcePath)
+		out.WriteString("type Client struct {\n\tapi govh.Client\n}\n\n")
+		out.WriteString("// New returns a Client calling the API with client, usually a *govh.Caller.\n")
+		out.WriteString("func New(client govh.Client) *Client {\n\treturn &Client{api: client}\n}\n\n")
+	}
+	out.Write(methods.Bytes())
+	out.Write(models.Bytes())
+
+	code, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("Invalid generated code: %s", err)
+	}
+	return code, nil
+}
+
+func (g *generator) writeImports(out *bytes.Buffer) {
+	var std, others []string
+	for path := range g.imports {
+		if strings.Contains(path, ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	if len(std)+len(others) == 0 {
+		return
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+
+	out.WriteString("import (\n")
+	for _, path := range std {
+		fmt.Fprintf(out, "\t%q\n", path)
+	}
+	if len(std) > 0 && len(others) > 0 {
+		out.WriteString("\n")
+	}
+	for _, path := range others {
+		if path == "github.com/garbage-collector/ovh-go" {
+			fmt.Fprintf(out, "\tgovh %q\n", path)
+		} else {
+			fmt.Fprintf(out, "\t%q\n", path)
+		}
+	}
+	out.WriteString(")\n\n")
+}
+
+// nameModels gives a unique Go name to every model of the API.
+func (g *generator) nameModels() {
+	var names []string
+	for name := range g.api.Models {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	taken := map[string]bool{}
+	for name := range reservedNames {
+		taken[name] = true
+	}
+	for _, name := range names {
+		goName := g.modelName(name)
+		if taken[goName] {
+			goName += "Model"
+		}
+		for i := 2; taken[goName]; i++ {
+			goName = fmt.Sprintf("%s%d", strings.TrimRight(goName, "0123456789"), i)
+		}
+		taken[goName] = true
+		g.typeNames[name] = goName
+		g.types[goName] = true
+	}
+}
+
+// modelName returns the Go name of a model, dropping the API name from its
+// namespace and namespace parts repeated by the next part, e.g. Zone for
+// domain.zone.Zone and ZoneRecord for domain.zone.Record.
+func (g *generator) modelName(name string) string {
+	parts := strings.Split(name, ".")
+	if len(parts) > 1 && parts[0] == g.root {
+		parts = parts[1:]
+	}
+
+	var goName string
+	for i, part := range parts {
+		if i < len(parts)-1 && strings.HasPrefix(strings.ToLower(parts[i+1]), strings.ToLower(part)) {
+			continue
+		}
+		goName += exported(part)
+	}
+	return goName
+}
+
+// goType returns the Go type of an API type. Generics are the type parameters
+// in scope.
+func (g *generator) goType(fullType string, generics []string) string {
+	if strings.HasSuffix(fullType, "[]") {
+		return "[]" + g.goType(strings.TrimSuffix(fullType, "[]"), generics)
+	}
+	for _, generic := range generics {
+		if fullType == generic {
+			return generic
+		}
+	}
+	if t, ok := primitiveTypes[fullType]; ok {
+		if strings.HasPrefix(t, "govh.") {
+			g.imports["github.com/garbage-collector/ovh-go"] = true
+		}
+		return t
+	}
+
+	name, args := fullType, ""
+	if i := strings.IndexByte(fullType, '<'); i >= 0 && strings.HasSuffix(fullType, ">") {
+		name = fullType[:i]
+		var goArgs []string
+		for _, arg := range splitTypeArgs(fullType[i+1 : len(fullType)-1]) {
+			goArgs = append(goArgs, g.goType(arg, generics))
+		}
+		args = "[" + strings.Join(goArgs, ", ") + "]"
+	}
+	model, ok := g.api.Models[name]
+	if !ok {
+		g.imports["encoding/json"] = true
+		return "json.RawMessage"
+	}
+	g.used[name] = true
+	if len(model.Generics) > 0 && args == "" {
+		g.imports["encoding/json"] = true
+		args = "[" + strings.TrimSuffix(strings.Repeat("json.RawMessage, ", len(model.Generics)), ", ") + "]"
+	}
+	return g.typeNames[name] + args
+}
+
+// isStruct tells whether an API type is an object model.
+func (g *generator) isStruct(fullType string) bool {
+	if i := strings.IndexByte(fullType, '<'); i >= 0 {
+		fullType = fullType[:i]
+	}
+	model, ok := g.api.Models[fullType]
+	return ok && !model.IsEnum()
+}
+
+// isEnum tells whether an API type is an enum model.
+func (g *generator) isEnum(fullType string) bool {
+	model, ok := g.api.Models[fullType]
+	return ok && model.IsEnum()
+}
+
+// model writes the type of a model.
+func (g *generator) model(name string, model *schema.Model) {
+	goName := g.typeNames[name]
+	writeComment(&g.buf, goName, model.Description, name)
+
+	if model.IsEnum() {
+		fmt.Fprintf(&g.buf, "type %s string\n\n", goName)
+		g.buf.WriteString("const (\n")
+		taken := map[string]bool{}
+		for _, value := range model.Enum {
+			constName := goName + exported(value)
+			for i := 2; taken[constName]; i++ {
+				constName = fmt.Sprintf("%s%s%d", goName, exported(value), i)
+			}
+			taken[constName] = true
+			fmt.Fprintf(&g.buf, "\t%s %s = %q\n", constName, goName, value)
+		}
+		g.buf.WriteString(")\n\n")
+		return
+	}
+
+	params := ""
+	if len(model.Generics) > 0 {
+		params = "[" + strings.Join(model.Generics, ", ") + " any]"
+	}
+	fmt.Fprintf(&g.buf, "type %s%s struct {\n", goName, params)
+	for _, propName := range sortedKeys(model.Properties) {
+		prop := model.Properties[propName]
+		fullType := prop.FullType
+		if fullType == "" {
+			fullType = prop.Type
+		}
+		t := g.goType(fullType, model.Generics)
+		if prop.CanBeNull && !strings.HasPrefix(t, "[]") && t != "json.RawMessage" {
+			if g.isStruct(fullType) {
+				t = "*" + t
+			} else {
+				g.imports["github.com/garbage-collector/ovh-go"] = true
+				t = "govh.Null[" + t + "]"
+			}
+		}
+		if prop.Description != "" {
+			fmt.Fprintf(&g.buf, "\t// %s\n", oneLine(prop.Description))
+		}
+		fmt.Fprintf(&g.buf, "\t%s %s `json:\"%s\"`\n", exported(propName), t, propName)
+	}
+	g.buf.WriteString("}\n\n")
+}
+
+// method writes the method of an operation.
+func (g *generator) method(route *schema.Route, operation *schema.Operation) {
+	name := g.methodName(route, operation)
+
+	var pathParams, queryParams, bodyParams []*schema.Parameter
+	for _, param := range operation.Parameters {
+		switch param.ParamType {
+		case "path":
+			pathParams = append(pathParams, param)
+		case "query":
+			queryParams = append(queryParams, param)
+		case "body":
+			bodyParams = append(bodyParams, param)
+		}
+	}
+
+	// Arguments, in the order of the path
+	sort.SliceStable(pathParams, func(i, j int) bool {
+		return strings.Index(route.Path, "{"+pathParams[i].Name+"}") < strings.Index(route.Path, "{"+pathParams[j].Name+"}")
+	})
+	args := []string{"ctx context.Context"}
+	g.imports["context"] = true
+	argNames := map[string]string{}
+	for _, param := range pathParams {
+		argName := unexported(param.Name)
+		if reservedArgs[argName] || isKeyword(argName) {
+			argName += "_"
+		}
+		argNames[param.Name] = argName
+		args = append(args, argName+" "+g.goType(param.FullType, nil))
+	}
+
+	queryType := ""
+	if len(queryParams) > 0 {
+		queryType = g.paramsType(name, "Params", queryParams, "url")
+		args = append(args, "params *"+queryType)
+	}
+
+	body := "nil"
+	switch {
+	case len(bodyParams) == 1 && bodyParams[0].Name == "":
+		t := g.goType(bodyParams[0].FullType, nil)
+		if g.isStruct(bodyParams[0].FullType) {
+			t = "*" + t
+		}
+		args = append(args, "body "+t)
+		body = "body"
+	case len(bodyParams) > 0:
+		bodyType := g.paramsType(name, "Body", bodyParams, "json")
+		args = append(args, "body *"+bodyType)
+		body = "body"
+	}
+	args = append(args, "opts ...govh.CallOption")
+
+	// Results
+	resultType, zero := "", ""
+	if operation.ResponseType != "" && operation.ResponseType != "void" {
+		resultType = g.goType(operation.ResponseType, nil)
+		zero = g.zero(operation.ResponseType, resultType)
+		if g.isStruct(operation.ResponseType) {
+			resultType = "*" + resultType
+		}
+	}
+
+	// Documentation
+	description := operation.Description
+	if description != "" {
+		description = ": " + lowerFirst(strings.TrimSuffix(oneLine(description), "."))
+	}
+	fmt.Fprintf(&g.buf, "// %s calls %s %s%s.\n", name, operation.HTTPMethod, route.Path, description)
+	if status := operation.APIStatus; status != nil && status.Value == "DEPRECATED" {
+		g.buf.WriteString("//\n// Deprecated: ")
+		if status.Replacement != "" {
+			fmt.Fprintf(&g.buf, "use %s instead.\n", status.Replacement)
+		} else {
+			fmt.Fprintf(&g.buf, "%s.\n", strings.TrimSuffix(oneLine(status.Description), "."))
+		}
+	}
+
+	results := "error"
+	if resultType != "" {
+		results = "(" + resultType + ", error)"
+	}
+	fmt.Fprintf(&g.buf, "func (client *Client) %s(%s) %s {\n", name, strings.Join(args, ", "), results)
+
+	errReturn := "return err"
+	if resultType != "" {
+		errReturn = "return " + zero + ", err"
+	}
+	if queryType != "" {
+		g.buf.WriteString("\tquery, err := govh.QueryFromStruct(params)\n")
+		fmt.Fprintf(&g.buf, "\tif err != nil {\n\t\t%s\n\t}\n", errReturn)
+		g.buf.WriteString("\topts = append(opts, govh.WithQuery(query))\n")
+	}
+
+	path := g.pathExpr(route.Path, pathParams, argNames)
+	if resultType == "" {
+		fmt.Fprintf(&g.buf, "\treturn client.api.CallAPIWithContext(ctx, %s, %q, %s, nil, opts...)\n}\n\n", path, operation.HTTPMethod, body)
+		return
+	}
+
+	if strings.HasPrefix(resultType, "*") {
+		fmt.Fprintf(&g.buf, "\tresult := &%s{}\n", resultType[1:])
+		fmt.Fprintf(&g.buf, "\tif err := client.api.CallAPIWithContext(ctx, %s, %q, %s, result, opts...); err != nil {\n", path, operation.HTTPMethod, body)
+	} else {
+		fmt.Fprintf(&g.buf, "\tvar result %s\n", resultType)
+		fmt.Fprintf(&g.buf, "\tif err := client.api.CallAPIWithContext(ctx, %s, %q, %s, &result, opts...); err != nil {\n", path, operation.HTTPMethod, body)
+	}
+	fmt.Fprintf(&g.buf, "\t\treturn %s, err\n\t}\n\treturn result, nil\n}\n\n", zero)
+}
+
+// methodName returns a unique name for the method of an operation, made of
+// the literal segments of its path relative to the API, and of a verb: List
+// or Get for GET, Create for POST on a collection, Update for PUT, Delete for
+// DELETE. Other POST operations are actions named after their path.
+func (g *generator) methodName(route *schema.Route, operation *schema.Operation) string {
+	relative := strings.TrimPrefix(route.Path, "/"+g.root)
+	segments := strings.Split(strings.Trim(relative, "/"), "/")
+	last := segments[len(segments)-1]
+	collection := last != "" && !isParameter(last)
+
+	var base string
+	for _, segment := range segments {
+		if segment != "" && !isParameter(segment) {
+			base += exported(segment)
+		}
+	}
+
+	var verb string
+	switch strings.ToUpper(operation.HTTPMethod) {
+	case "GET":
+		verb = "Get"
+		if strings.HasSuffix(operation.ResponseType, "[]") && (collection || base == "") {
+			verb = "List"
+		}
+	case "POST":
+		if get := route.Operation("GET"); base == "" || (collection && get != nil && strings.HasSuffix(get.ResponseType, "[]")) {
+			verb = "Create"
+		}
+	case "PUT":
+		verb = "Update"
+	case "DELETE":
+		verb = "Delete"
+	default:
+		verb = exported(strings.ToLower(operation.HTTPMethod))
+	}
+
+	name := base + verb
+	if g.methods[name] {
+		var by string
+		for _, segment := range segments {
+			if isParameter(segment) {
+				by += exported(segment[1 : len(segment)-1])
+			}
+		}
+		if by != "" {
+			name += "By" + by
+		}
+	}
+	for i := 2; g.methods[name]; i++ {
+		name = fmt.Sprintf("%s%s%d", base, verb, i)
+	}
+	g.methods[name] = true
+	return name
+}
+
+// paramsType writes a struct of query or body parameters, and returns its name.
+func (g *generator) paramsType(method, suffix string, params []*schema.Parameter, tag string) string {
+	name := method + suffix
+	for i := 2; g.types[name]; i++ {
+		name = fmt.Sprintf("%s%s%d", method, suffix, i)
+	}
+	g.types[name] = true
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// %s are the %s parameters of %s.\n", name, map[string]string{"url": "query", "json": "body"}[tag], method)
+	fmt.Fprintf(&buf, "type %s struct {\n", name)
+	for _, param := range params {
+		t := g.goType(param.FullType, nil)
+		if tag == "url" && t == "govh.DateTime" {
+			g.imports["time"] = true
+			t = "time.Time"
+		}
+		if param.Description != "" {
+			fmt.Fprintf(&buf, "\t// %s\n", oneLine(param.Description))
+		}
+		options := ""
+		if !param.Required {
+			options = ",omitempty"
+		}
+		fmt.Fprintf(&buf, "\t%s %s `%s:\"%s%s\"`\n", exported(param.Name), t, tag, param.Name, options)
+	}
+	buf.WriteString("}\n\n")
+
+	// The method is written after its parameter types
+	g.buf.Write(buf.Bytes())
+	return name
+}
+
+// pathExpr returns the Go expression of the path of a call.
+func (g *generator) pathExpr(path string, params []*schema.Parameter, argNames map[string]string) string {
+	types := map[string]string{}
+	for _, param := range params {
+		types[param.Name] = param.FullType
+	}
+
+	var format strings.Builder
+	var values []string
+	for i, segment := range strings.Split(path, "/") {
+		if i > 0 {
+			format.WriteString("/")
+		}
+		if !isParameter(segment) {
+			format.WriteString(strings.ReplaceAll(segment, "%", "%%"))
+			continue
+		}
+
+		param := segment[1 : len(segment)-1]
+		arg, ok := argNames[param]
+		if !ok {
+			// Undeclared parameter: keep the template segment
+			format.WriteString(segment)
+			continue
+		}
+		switch {
+		case types[param] == "long" || types[param] == "int":
+			format.WriteString("%d")
+			values = append(values, arg)
+		case g.isEnum(types[param]):
+			format.WriteString("%s")
+			values = append(values, "url.PathEscape(string("+arg+"))")
+			g.imports["net/url"] = true
+		case g.goType(types[param], nil) == "string":
+			format.WriteString("%s")
+			values = append(values, "url.PathEscape("+arg+")")
+			g.imports["net/url"] = true
+		default:
+			format.WriteString("%s")
+			values = append(values, "url.PathEscape(fmt.Sprint("+arg+"))")
+			g.imports["net/url"] = true
+		}
+	}
+
+	if len(values) == 0 {
+		return fmt.Sprintf("%q", format.String())
+	}
+	g.imports["fmt"] = true
+	return fmt.Sprintf("fmt.Sprintf(%q, %s)", format.String(), strings.Join(values, ", "))
+}
+
+// zero returns the zero value of the result of an operation.
+func (g *generator) zero(fullType, goType string) string {
+	switch {
+	case g.isStruct(fullType), strings.HasPrefix(goType, "[]"), goType == "json.RawMessage":
+		return "nil"
+	case goType == "string", g.isEnum(fullType):
+		return `""`
+	case goType == "int64", goType == "float64":
+		return "0"
+	case goType == "bool":
+		return "false"
+	}
+	return goType + "{}"
+}
+
+func hasPrefix(path string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if path == prefix || strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func isParameter(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// splitTypeArgs splits the type arguments of a generic type, e.g.
+// "string,complexType.UnitAndValue<long>".
+func splitTypeArgs(args string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range args {
+		switch r {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(args[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, strings.TrimSpace(args[start:]))
+}
+
+// initialisms are written upper case in Go names.
+var initialisms = map[string]bool{
+	"Id": true, "Ip": true, "Ips": true, "Url": true, "Dns": true, "Ssh": true,
+	"Ttl": true, "Api": true, "Http": true, "Https": true, "Uuid": true, "Vm": true,
+}
+
+// exported returns an exported Go identifier for a name of the API, such as
+// "zoneName", "in-progress" or "2fa".
+func exported(name string) string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+	}
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])):
+			flush()
+		}
+		word = append(word, r)
+	}
+	flush()
+
+	var out strings.Builder
+	for _, w := range words {
+		w = strings.ToUpper(w[:1]) + w[1:]
+		if initialisms[w] {
+			w = strings.ToUpper(w)
+		}
+		out.WriteString(w)
+	}
+	s := out.String()
+	if s == "" || unicode.IsDigit([]rune(s)[0]) {
+		s = "V" + s
+	}
+	return s
+}
+
+// unexported returns an unexported Go identifier for a name of the API.
+func unexported(name string) string {
+	s := exported(name)
+	runes := []rune(s)
+	// Lower the leading upper case run, but the start of the next word
+	i := 0
+	for i < len(runes) && unicode.IsUpper(runes[i]) {
+		i++
+	}
+	if i > 1 && i < len(runes) {
+		i--
+	}
+	return strings.ToLower(string(runes[:i])) + string(runes[i:])
+}
+
+func isKeyword(name string) bool {
+	switch name {
+	case "break", "case", "chan", "const", "continue", "default", "defer", "else",
+		"fallthrough", "for", "func", "go", "goto", "if", "import", "interface",
+		"map", "package", "range", "return", "select", "struct", "switch", "type", "var":
+		return true
+	}
+	return false
+}
+
+func lowerFirst(s string) string {
+	runes := []rune(s)
+	if len(runes) > 1 && unicode.IsUpper(runes[0]) && !unicode.IsUpper(runes[1]) {
+		runes[0] = unicode.ToLower(runes[0])
+	}
+	return string(runes)
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// writeComment writes the documentation of a type.
+func writeComment(buf *bytes.Buffer, goName, description, name string) {
+	if description == "" {
+		fmt.Fprintf(buf, "// %s is the %s model.\n", goName, name)
+		return
+	}
+	fmt.Fprintf(buf, "// %s is the %s model: %s.\n", goName, name, lowerFirst(strings.TrimSuffix(oneLine(description), ".")))
+}
+
+func sortedKeys(properties map[string]*schema.Property) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/schema"
+)
+
+const testSchema = `{
+  "apiVersion": "1.0",
+  "resourcePath": "/domain",
+  "basePath": "https://eu.api.ovh.com/1.0",
+  "apis": [
+    {
+      "path": "/domain/zone",
+      "operations": [
+        {"httpMethod": "GET", "description": "List available services", "responseType": "string[]", "parameters": []}
+      ]
+    },
+    {
+      "path": "/domain/zone/{zoneName}",
+      "operations": [
+        {"httpMethod": "GET", "description": "Get this object properties", "responseType": "domain.zone.Zone", "parameters": [
+          {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true}
+        ]}
+      ]
+    },
+    {
+      "path": "/domain/zone/{zoneName}/record",
+      "operations": [
+        {"httpMethod": "GET", "description": "Records of the zone", "responseType": "long[]", "parameters": [
+          {"name": "fieldType", "paramType": "query", "dataType": "zone.NamedResolutionFieldTypeEnum", "fullType": "zone.NamedResolutionFieldTypeEnum", "required": false, "description": "Filter the value of fieldType property (=)"},
+          {"name": "subDomain", "paramType": "query", "dataType": "string", "fullType": "string", "required": false},
+          {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true}
+        ]},
+        {"httpMethod": "POST", "description": "Create a new DNS record (Don't forget to refresh the zone)", "responseType": "domain.zone.Record", "parameters": [
+          {"name": "fieldType", "paramType": "body", "dataType": "zone.NamedResolutionFieldTypeEnum", "fullType": "zone.NamedResolutionFieldTypeEnum", "required": true},
+          {"name": "target", "paramType": "body", "dataType": "string", "fullType": "string", "required": true},
+          {"name": "ttl", "paramType": "body", "dataType": "long", "fullType": "long", "required": false},
+          {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true}
+        ]}
+      ]
+    },
+    {
+      "path": "/domain/zone/{zoneName}/record/{id}",
+      "operations": [
+        {"httpMethod": "GET", "description": "Get this object properties", "responseType": "domain.zone.Record", "parameters": [
+          {"name": "id", "paramType": "path", "dataType": "long", "fullType": "long", "required": true},
+          {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true}
+        ]},
+        {"httpMethod": "PUT", "description": "Alter this object properties", "responseType": "void", "parameters": [
+          {"name": "", "paramType": "body", "dataType": "domain.zone.Record", "fullType": "domain.zone.Record", "required": true},
+          {"name": "id", "paramType": "path", "dataType": "long", "fullType": "long", "required": true},
+          {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true}
+        ]},
+        {"httpMethod": "DELETE", "description": "Delete a DNS record", "responseType": "void", "apiStatus": {"value": "DEPRECATED", "description": "Deprecated", "replacement": "/v2/dns"}, "parameters": [
+          {"name": "id", "paramType": "path", "dataType": "long", "fullType": "long", "required": true},
+          {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true}
+        ]}
+      ]
+    },
+    {
+      "path": "/domain/zone/{zoneName}/refresh",
+      "operations": [
+        {"httpMethod": "POST", "description": "Apply zone modification on DNS servers", "responseType": "void", "parameters": [
+          {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true}
+        ]}
+      ]
+    },
+    {
+      "path": "/domain/zone/{zoneName}/statistics",
+      "operations": [
+        {"httpMethod": "GET", "description": "Zone statistics", "responseType": "complexType.UnitAndValue<long>", "parameters": [
+          {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true},
+          {"name": "since", "paramType": "query", "dataType": "datetime", "fullType": "datetime", "required": true}
+        ]}
+      ]
+    },
+    {
+      "path": "/domain/{serviceName}",
+      "operations": [
+        {"httpMethod": "GET", "description": "Get this object properties", "responseType": "domain.Domain", "apiStatus": {"value": "DELETED", "description": "Deleted"}, "parameters": [
+          {"name": "serviceName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true}
+        ]}
+      ]
+    }
+  ],
+  "models": {
+    "domain.zone.Zone": {
+      "id": "Zone", "namespace": "domain.zone", "description": "Zone dns Management",
+      "properties": {
+        "name": {"type": "string", "fullType": "string", "canBeNull": false, "readOnly": true, "description": "Zone name"},
+        "nameServers": {"type": "string[]", "fullType": "string[]", "canBeNull": false, "readOnly": true},
+        "lastUpdate": {"type": "datetime", "fullType": "datetime", "canBeNull": true, "readOnly": true}
+      }
+    },
+    "domain.zone.Record": {
+      "id": "Record", "namespace": "domain.zone", "description": "Zone resource records",
+      "properties": {
+        "id": {"type": "long", "fullType": "long", "canBeNull": false, "readOnly": true},
+        "fieldType": {"type": "zone.NamedResolutionFieldTypeEnum", "fullType": "zone.NamedResolutionFieldTypeEnum", "canBeNull": false, "readOnly": true},
+        "target": {"type": "string", "fullType": "string", "canBeNull": false, "readOnly": false},
+        "ttl": {"type": "long", "fullType": "long", "canBeNull": true, "readOnly": false},
+        "zone": {"type": "domain.zone.Zone", "fullType": "domain.zone.Zone", "canBeNull": true, "readOnly": true}
+      }
+    },
+    "domain.Domain": {
+      "id": "Domain", "namespace": "domain", "description": "Domain name",
+      "properties": {"domain": {"type": "string", "fullType": "string", "canBeNull": false}}
+    },
+    "zone.NamedResolutionFieldTypeEnum": {
+      "id": "NamedResolutionFieldTypeEnum", "namespace": "zone", "description": "Resource record fieldType",
+      "enumType": "string", "enum": ["A", "AAAA", "CNAME", "in-progress", "2fa"]
+    },
+    "complexType.UnitAndValue": {
+      "id": "UnitAndValue", "namespace": "complexType", "description": "A numeric value tagged with its unit",
+      "generics": ["T"],
+      "properties": {
+        "unit": {"type": "string", "fullType": "string", "canBeNull": false},
+        "value": {"type": "T", "fullType": "T", "canBeNull": false}
+      }
+    }
+  }
+}`
+
+func TestGenerate(t *testing.T) {
+	api, err := schema.Parse(strings.NewReader(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, err := generate(api, "zone", []string{"/domain/zone"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Compare without the alignment of gofmt
+	normalized := strings.Join(strings.Fields(string(code)), " ")
+	for _, expected := range []string{
+		"// Code generated by govh-gen from the /domain API. DO NOT EDIT.",
+		"func (client *Client) ZoneList(ctx context.Context, opts ...govh.CallOption) ([]string, error)",
+		"func (client *Client) ZoneGet(ctx context.Context, zoneName string, opts ...govh.CallOption) (*Zone, error)",
+		"func (client *Client) ZoneRecordList(ctx context.Context, zoneName string, params *ZoneRecordListParams, opts ...govh.CallOption) ([]int64, error)",
+		"func (client *Client) ZoneRecordCreate(ctx context.Context, zoneName string, body *ZoneRecordCreateBody, opts ...govh.CallOption) (*ZoneRecord, error)",
+		"func (client *Client) ZoneRecordGet(ctx context.Context, zoneName string, id int64, opts ...govh.CallOption) (*ZoneRecord, error)",
+		"func (client *Client) ZoneRecordUpdate(ctx context.Context, zoneName string, id int64, body *ZoneRecord, opts ...govh.CallOption) error",
+		"func (client *Client) ZoneRefresh(ctx context.Context, zoneName string, opts ...govh.CallOption) error",
+		"func (client *Client) ZoneStatisticsGet(ctx context.Context, zoneName string, params *ZoneStatisticsGetParams, opts ...govh.CallOption) (*ComplexTypeUnitAndValue[int64], error)",
+		`fmt.Sprintf("/domain/zone/%s/record/%d", url.PathEscape(zoneName), id)`,
+		"// Deprecated: use /v2/dns instead.",
+		"FieldType ZoneNamedResolutionFieldTypeEnum `url:\"fieldType,omitempty\"`",
+		"Since time.Time `url:\"since\"`",
+		"TTL govh.Null[int64] `json:\"ttl\"`",
+		"Zone *Zone `json:\"zone\"`",
+		"LastUpdate govh.Null[govh.DateTime] `json:\"lastUpdate\"`",
+		"ZoneNamedResolutionFieldTypeEnumInProgress ZoneNamedResolutionFieldTypeEnum = \"in-progress\"",
+		"ZoneNamedResolutionFieldTypeEnumV2fa ZoneNamedResolutionFieldTypeEnum = \"2fa\"",
+		"type ComplexTypeUnitAndValue[T any] struct",
+	} {
+		if !strings.Contains(normalized, expected) {
+			t.Errorf("expected %q in generated code", expected)
+		}
+	}
+	for _, unexpected := range []string{"type Domain struct", "ServiceName"} {
+		if strings.Contains(string(code), unexpected) {
+			t.Errorf("unexpected %q in generated code", unexpected)
+		}
+	}
+	if t.Failed() {
+		t.Log(string(code))
+	}
+
+	// The generated package must compile
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "zz_generated.go", code, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := config.Check("zone", fset, []*ast.File{file}, nil); err != nil {
+		t.Fatalf("generated code doesn't compile: %s\n%s", err, code)
+	}
+}
+
+func TestExported(t *testing.T) {
+	for name, expected := range map[string]string{
+		"zoneName":    "ZoneName",
+		"serviceId":   "ServiceID",
+		"in-progress": "InProgress",
+		"ipv4":        "Ipv4",
+		"2fa":         "V2fa",
+		"DKIM":        "DKIM",
+		"ttl":         "TTL",
+	} {
+		if got := exported(name); got != expected {
+			t.Errorf("exported(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+	if got := unexported("IPBlock"); got != "ipBlock" {
+		t.Errorf("unexpected unexported name %q", got)
+	}
+}
@@ -0,0 +1,88 @@
+// Command govh-gen generates a typed Go client for routes of the OVH API,
+// from the API self-description:
+//
+//	govh-gen -api domain -prefix /domain/zone -package zone -o zone/zz_generated.go
+//
+// The generated package has a Client wrapping a govh.Client, with a method
+// per operation, such as ZoneRecordList for GET /domain/zone/{zoneName}/record,
+// and a type per model used by those operations.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	govh "github.com/garbage-collector/ovh-go"
+	"github.com/garbage-collector/ovh-go/schema"
+)
+
+func main() {
+	var (
+		endpoint   = flag.String("endpoint", "ovh-eu", "API endpoint to fetch the description from")
+		apiName    = flag.String("api", "", "API to generate a client for, e.g. domain or dedicated/server")
+		schemaFile = flag.String("schema", "", "read the API description from this file instead of the endpoint")
+		prefixes   = flag.String("prefix", "", "comma-separated path prefixes of the routes to generate, all routes of the API by default")
+		pkg        = flag.String("package", "", "package name, the last element of the API name by default")
+		output     = flag.String("o", "", "output file, standard output by default")
+		withClient = flag.Bool("client", true, "generate the Client type and New, disable when generating several files of a package")
+	)
+	flag.Parse()
+
+	if *apiName == "" && *schemaFile == "" {
+		fmt.Fprintln(os.Stderr, "govh-gen: -api or -schema is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	api, err := loadAPI(*endpoint, *apiName, *schemaFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "govh-gen:", err)
+		os.Exit(1)
+	}
+
+	if *pkg == "" {
+		*pkg = path.Base(api.ResourcePath)
+	}
+	var filters []string
+	if *prefixes != "" {
+		filters = strings.Split(*prefixes, ",")
+	}
+
+	code, err := generate(api, *pkg, filters, *withClient)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "govh-gen:", err)
+		os.Exit(1)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(code)
+		return
+	}
+	if err := ioutil.WriteFile(*output, code, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, "govh-gen:", err)
+		os.Exit(1)
+	}
+}
+
+// loadAPI reads the API description from file, or fetches it from endpoint.
+func loadAPI(endpoint, name, file string) (*schema.API, error) {
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return schema.Parse(f)
+	}
+
+	caller, err := govh.NewClient(endpoint, govh.WithoutTimeSync())
+	if err != nil {
+		return nil, err
+	}
+	return schema.New(caller).API(context.Background(), name)
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	govh "github.com/garbage-collector/ovh-go"
+	"github.com/garbage-collector/ovh-go/schema"
+)
+
+const bashCompletion = `_ovh() {
+	local cur=${COMP_WORDS[COMP_CWORD]}
+	if [ "$COMP_CWORD" -eq 1 ]; then
+		COMPREPLY=($(compgen -W "get post put delete login console completion" -- "$cur"))
+	elif [ "$COMP_CWORD" -eq 2 ]; then
+		COMPREPLY=($(ovh __complete "${COMP_WORDS[1]}" "$cur" 2>/dev/null))
+	fi
+}
+complete -o nospace -F _ovh ovh
+`
+
+// schemaCacheTTL is how long API descriptions are cached for completion.
+const schemaCacheTTL = 24 * time.Hour
+
+// printCompletion writes the completion script of shell to w.
+func printCompletion(w io.Writer, shell string) error {
+	switch shell {
+	case "bash":
+		_, err := io.WriteString(w, bashCompletion)
+		return err
+	case "zsh":
+		_, err := io.WriteString(w, "autoload -U +X bashcompinit && bashcompinit\n"+bashCompletion)
+		return err
+	}
+	return fmt.Errorf("unsupported shell %q, expected bash or zsh", shell)
+}
+
+// complete writes the completions of a path to w, one per line. Arguments
+// are the command, such as get, and the path to complete. Path segments are
+// completed from the API descriptions and, for identifiers, by listing the
+// resources when credentials are available.
+func complete(ctx context.Context, opts *options, w io.Writer, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: ovh __complete COMMAND PATH")
+	}
+	method, prefix := strings.ToUpper(args[0]), args[1]
+	if !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+
+	endpoint := opts.endpoint
+	var caller *govh.Caller
+	if config, err := loadConfig(opts); err == nil {
+		endpoint = config.Endpoint
+		caller, _ = govh.NewClientWithContext(ctx, endpoint, append(config.Options(), govh.WithoutTimeSync())...)
+	}
+	if endpoint == "" {
+		endpoint = "ovh-eu"
+	}
+	if caller == nil {
+		var err error
+		if caller, err = govh.NewClientWithContext(ctx, endpoint, govh.WithoutTimeSync()); err != nil {
+			return err
+		}
+	}
+	schemas := &schemaCache{client: schema.New(caller), endpoint: endpoint}
+
+	index, err := schemas.index(ctx)
+	if err != nil {
+		return err
+	}
+
+	var entry *schema.IndexEntry
+	for _, e := range index.APIs {
+		if strings.HasPrefix(prefix, e.Path+"/") && (entry == nil || len(e.Path) > len(entry.Path)) {
+			entry = e
+		}
+	}
+
+	var candidates []string
+	if entry == nil {
+		for _, e := range index.APIs {
+			if strings.HasPrefix(e.Path, prefix) {
+				candidates = append(candidates, e.Path)
+			}
+		}
+	} else {
+		api, err := schemas.api(ctx, entry.Name())
+		if err != nil {
+			return err
+		}
+
+		var listPath string
+		candidates, listPath = completePaths(api, method, prefix)
+		if listPath != "" && caller.ConsumerKey != "" {
+			candidates = append(candidates, completeIDs(ctx, caller, listPath, prefix)...)
+		}
+	}
+
+	sort.Strings(candidates)
+	for _, candidate := range candidates {
+		fmt.Fprintln(w, candidate)
+	}
+	return nil
+}
+
+// completePaths returns the completions of prefix from the routes of api
+// supporting method. When the segment being completed is a parameter, the
+// path listing its values is returned too, if the API has one.
+func completePaths(api *schema.API, method, prefix string) ([]string, string) {
+	segments := strings.Split(prefix, "/")
+	done, partial := segments[:len(segments)-1], segments[len(segments)-1]
+	parent := strings.Join(done, "/")
+
+	seen := map[string]bool{}
+	var candidates []string
+	var listPath string
+	for _, route := range api.Routes {
+		if route.Operation(method) == nil {
+			continue
+		}
+
+		template := strings.Split(route.Path, "/")
+		if len(template) < len(segments) || !matchSegments(template[:len(done)], done) {
+			continue
+		}
+
+		next := template[len(done)]
+		if isParameter(next) {
+			if list := api.Route(parent); list != nil {
+				if operation := list.Operation("GET"); operation != nil && strings.HasSuffix(operation.ResponseType, "[]") {
+					listPath = parent
+				}
+			}
+			continue
+		}
+		if !strings.HasPrefix(next, partial) {
+			continue
+		}
+
+		candidate := parent + "/" + next
+		if len(template) > len(segments) {
+			candidate += "/"
+		}
+		if !seen[candidate] {
+			seen[candidate] = true
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates, listPath
+}
+
+// completeIDs lists the resources of listPath, and returns those completing
+// prefix. Errors are ignored, completion being best effort.
+func completeIDs(ctx context.Context, caller *govh.Caller, listPath, prefix string) []string {
+	var ids []interface{}
+	if err := caller.GetWithContext(ctx, listPath, &ids); err != nil {
+		return nil
+	}
+
+	var candidates []string
+	for _, id := range ids {
+		candidate := listPath + "/" + url.PathEscape(fmt.Sprint(id))
+		if strings.HasPrefix(candidate, prefix) {
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates
+}
+
+// matchSegments tells whether path segments match template segments.
+func matchSegments(template, segments []string) bool {
+	for i, segment := range template {
+		if segment != segments[i] && (!isParameter(segment) || segments[i] == "") {
+			return false
+		}
+	}
+	return true
+}
+
+func isParameter(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// schemaCache fetches API descriptions, caching them in the user's cache
+// directory, as they are large and rarely change.
+type schemaCache struct {
+	client   *schema.Client
+	endpoint string
+}
+
+// index returns the list of APIs.
+func (cache *schemaCache) index(ctx context.Context) (*schema.Index, error) {
+	var index schema.Index
+	err := cache.load("index", &index, func() (interface{}, error) {
+		return cache.client.Index(ctx)
+	})
+	return &index, err
+}
+
+// api returns the description of the API name.
+func (cache *schemaCache) api(ctx context.Context, name string) (*schema.API, error) {
+	var api schema.API
+	err := cache.load(name, &api, func() (interface{}, error) {
+		return cache.client.API(ctx, name)
+	})
+	return &api, err
+}
+
+// load reads the entry name of the cache into result, or fetches it.
+func (cache *schemaCache) load(name string, result interface{}, fetch func() (interface{}, error)) error {
+	var path string
+	if dir, err := os.UserCacheDir(); err == nil {
+		escape := strings.NewReplacer("/", "_", ":", "_").Replace
+		path = filepath.Join(dir, "ovh", escape(cache.endpoint), escape(name)+".json")
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) < schemaCacheTTL {
+			if data, err := ioutil.ReadFile(path); err == nil && json.Unmarshal(data, result) == nil {
+				return nil
+			}
+		}
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if path != "" && os.MkdirAll(filepath.Dir(path), 0700) == nil {
+		ioutil.WriteFile(path, data, 0600)
+	}
+	return json.Unmarshal(data, result)
+}
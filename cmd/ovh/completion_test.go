@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+	"github.com/garbage-collector/ovh-go/schema"
+)
+
+const testSchema = `{
+  "apiVersion": "1.0",
+  "resourcePath": "/domain",
+  "apis": [
+    {"path": "/domain/zone", "operations": [{"httpMethod": "GET", "responseType": "string[]", "parameters": []}]},
+    {"path": "/domain/zone/{zoneName}", "operations": [{"httpMethod": "GET", "responseType": "domain.zone.Zone", "parameters": []}]},
+    {"path": "/domain/zone/{zoneName}/record", "operations": [{"httpMethod": "GET", "responseType": "long[]", "parameters": []}]},
+    {"path": "/domain/zone/{zoneName}/refresh", "operations": [{"httpMethod": "POST", "responseType": "void", "parameters": []}]}
+  ],
+  "models": {}
+}`
+
+func TestCompletePaths(t *testing.T) {
+	api, err := schema.Parse(strings.NewReader(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		method, prefix string
+		candidates     []string
+		listPath       string
+	}{
+		{"GET", "/domain/z", []string{"/domain/zone", "/domain/zone/"}, ""},
+		{"POST", "/domain/z", []string{"/domain/zone/"}, ""},
+		{"GET", "/domain/zone/", nil, "/domain/zone"},
+		{"GET", "/domain/zone/example.com/", []string{"/domain/zone/example.com/record"}, ""},
+		{"POST", "/domain/zone/example.com/r", []string{"/domain/zone/example.com/refresh"}, ""},
+		{"DELETE", "/domain/", nil, ""},
+	}
+	for _, test := range tests {
+		candidates, listPath := completePaths(api, test.method, test.prefix)
+		if !reflect.DeepEqual(candidates, test.candidates) || listPath != test.listPath {
+			t.Fatalf("%s %s: expected %q and %q, got %q and %q", test.method, test.prefix, test.candidates, test.listPath, candidates, listPath)
+		}
+	}
+}
+
+func TestCompleteIDs(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain/zone", 200, []string{"example.com", "example.org", "other.net"})
+
+	candidates := completeIDs(context.Background(), server.Caller(), "/domain/zone", "/domain/zone/ex")
+	expected := []string{"/domain/zone/example.com", "/domain/zone/example.org"}
+	if !reflect.DeepEqual(candidates, expected) {
+		t.Fatalf("Expected %q, got %q", expected, candidates)
+	}
+}
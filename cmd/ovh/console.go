@@ -0,0 +1,351 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	govh "github.com/garbage-collector/ovh-go"
+	"github.com/garbage-collector/ovh-go/schema"
+)
+
+const consoleHelp = `Commands:
+  apis                 list the APIs
+  use API              select an API, such as domain or dedicated/server
+  routes [PREFIX]      list the routes of the selected API
+  METHOD PATH          call a route, such as get /domain/zone/{zoneName},
+                       prompting for its parameters
+  help                 show this help
+  exit                 leave the console
+`
+
+// console is an interactive API explorer, showing the routes of the APIs
+// and the signed requests sent to them.
+type console struct {
+	caller *govh.Caller
+	in     *bufio.Scanner
+	out    io.Writer
+
+	// index and api fetch the API descriptions.
+	index func(ctx context.Context) (*schema.Index, error)
+	api   func(ctx context.Context, name string) (*schema.API, error)
+
+	// apis are the descriptions fetched so far, by name.
+	apis    map[string]*schema.API
+	current string
+}
+
+// runConsole starts the console on the standard input and output.
+func runConsole(ctx context.Context, opts *options, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("usage: ovh console")
+	}
+
+	caller, err := newCaller(ctx, opts)
+	if err != nil {
+		return err
+	}
+	config, _ := loadConfig(opts)
+	schemas := &schemaCache{client: schema.New(caller), endpoint: config.Endpoint}
+
+	c := newConsole(caller, os.Stdin, os.Stdout)
+	c.index = schemas.index
+	c.api = schemas.api
+	return c.run(ctx)
+}
+
+// newConsole creates a console for caller. Its signed requests are
+// written to out.
+func newConsole(caller *govh.Caller, in io.Reader, out io.Writer) *console {
+	c := &console{
+		caller: caller.Clone(),
+		in:     bufio.NewScanner(in),
+		out:    out,
+		apis:   map[string]*schema.API{},
+	}
+	c.caller.Use(c.showRequest)
+	return c
+}
+
+// run reads and runs commands until the input ends or exit is entered.
+func (c *console) run(ctx context.Context) error {
+	fmt.Fprintln(c.out, "OVH API console, enter help for the list of commands.")
+	for {
+		line, ok := c.prompt("ovh" + c.current + "> ")
+		if !ok {
+			fmt.Fprintln(c.out)
+			return c.in.Err()
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "exit" || fields[0] == "quit" {
+			return nil
+		}
+		if err := c.command(ctx, fields[0], fields[1:]); err != nil {
+			fmt.Fprintln(c.out, "Error:", err)
+		}
+	}
+}
+
+// prompt writes prompt, and reads a line.
+func (c *console) prompt(prompt string) (string, bool) {
+	fmt.Fprint(c.out, prompt)
+	if !c.in.Scan() {
+		return "", false
+	}
+	return strings.TrimSpace(c.in.Text()), true
+}
+
+// command runs a command.
+func (c *console) command(ctx context.Context, name string, args []string) error {
+	switch strings.ToLower(name) {
+	case "help":
+		fmt.Fprint(c.out, consoleHelp)
+		return nil
+
+	case "apis":
+		index, err := c.index(ctx)
+		if err != nil {
+			return err
+		}
+		for _, entry := range index.APIs {
+			fmt.Fprintf(c.out, "%-30s %s\n", entry.Name(), entry.Description)
+		}
+		return nil
+
+	case "use":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: use API")
+		}
+		name := strings.Trim(args[0], "/")
+		if _, err := c.loadAPI(ctx, name); err != nil {
+			return err
+		}
+		c.current = "/" + name
+		return nil
+
+	case "routes":
+		if c.current == "" {
+			return fmt.Errorf("no API selected, see use")
+		}
+		api, err := c.loadAPI(ctx, strings.TrimPrefix(c.current, "/"))
+		if err != nil {
+			return err
+		}
+		prefix := ""
+		if len(args) > 0 {
+			prefix = args[0]
+		}
+		for _, route := range api.Routes {
+			if !strings.HasPrefix(route.Path, prefix) {
+				continue
+			}
+			for _, operation := range route.Operations {
+				fmt.Fprintf(c.out, "%-6s %s\n", operation.HTTPMethod, route.Path)
+				if operation.Description != "" {
+					fmt.Fprintf(c.out, "       %s\n", operation.Description)
+				}
+			}
+		}
+		return nil
+
+	case "get", "post", "put", "delete":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: %s PATH", name)
+		}
+		return c.call(ctx, strings.ToUpper(name), args[0])
+	}
+	return fmt.Errorf("unknown command %q, see help", name)
+}
+
+// loadAPI returns the description of the API name.
+func (c *console) loadAPI(ctx context.Context, name string) (*schema.API, error) {
+	if api, ok := c.apis[name]; ok {
+		return api, nil
+	}
+	api, err := c.api(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	c.apis[name] = api
+	return api, nil
+}
+
+// apiOf returns the description of the API serving path, among the APIs
+// listed by the index.
+func (c *console) apiOf(ctx context.Context, path string) (*schema.API, error) {
+	if c.current != "" && (path == c.current || strings.HasPrefix(path, c.current+"/")) {
+		return c.loadAPI(ctx, strings.TrimPrefix(c.current, "/"))
+	}
+
+	index, err := c.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var entry *schema.IndexEntry
+	for _, e := range index.APIs {
+		if (path == e.Path || strings.HasPrefix(path, e.Path+"/")) && (entry == nil || len(e.Path) > len(entry.Path)) {
+			entry = e
+		}
+	}
+	if entry == nil {
+		return nil, fmt.Errorf("no API serves %s", path)
+	}
+	return c.loadAPI(ctx, entry.Name())
+}
+
+// call prompts for the parameters of a route, calls it and prints the
+// response.
+func (c *console) call(ctx context.Context, method, path string) error {
+	if !strings.HasPrefix(path, "/") {
+		path = c.current + "/" + path
+	}
+
+	api, err := c.apiOf(ctx, path)
+	if err != nil {
+		return err
+	}
+	route, operation := api.Operation(method, path)
+	if route == nil {
+		return fmt.Errorf("unknown route %s", path)
+	}
+	if operation == nil {
+		return fmt.Errorf("%s doesn't support %s", route.Path, method)
+	}
+
+	// Fill the parameters left in the path.
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if !isParameter(segment) {
+			continue
+		}
+		value, ok := c.prompt(segment[1:len(segment)-1] + ": ")
+		if !ok || value == "" {
+			return fmt.Errorf("missing parameter %s", segment)
+		}
+		segments[i] = url.PathEscape(value)
+	}
+	path = strings.Join(segments, "/")
+
+	query := url.Values{}
+	fields := map[string]interface{}{}
+	var body interface{}
+	for _, param := range operation.Parameters {
+		if param.ParamType != "query" && param.ParamType != "body" {
+			continue
+		}
+
+		value, ok := c.prompt(c.describe(api, param) + ": ")
+		if !ok {
+			return fmt.Errorf("missing parameter %s", param.Name)
+		}
+		if value == "" {
+			if param.Required {
+				return fmt.Errorf("parameter %s is required", param.Name)
+			}
+			continue
+		}
+
+		switch {
+		case param.ParamType == "query":
+			query.Set(param.Name, value)
+		case param.Name == "":
+			if !json.Valid([]byte(value)) {
+				return fmt.Errorf("body must be JSON")
+			}
+			body = json.RawMessage(value)
+		default:
+			fields[param.Name] = bodyValue(api, param, value)
+		}
+	}
+	if len(fields) > 0 {
+		body = fields
+	}
+
+	response, err := c.caller.CallAPIRaw(ctx, path, method, body, nil, govh.WithQuery(query))
+	if response != nil {
+		fmt.Fprintf(c.out, "< %d %s\n", response.StatusCode, http.StatusText(response.StatusCode))
+		if err := printResponse(c.out, "json", response.Body); err != nil {
+			return err
+		}
+	}
+	if govh.IsForbidden(err) {
+		fmt.Fprintf(c.out, "The access rules of the consumer key may not allow %s %s.\n", method, route.Path)
+	}
+	return err
+}
+
+// describe returns the prompt of a parameter: its name, type, allowed values
+// and whether it is required.
+func (c *console) describe(api *schema.API, param *schema.Parameter) string {
+	name := param.Name
+	if name == "" {
+		name = "body"
+	}
+	details := []string{param.FullType}
+	if model := api.Model(param.FullType); model != nil && model.IsEnum() {
+		details = append(details, strings.Join(model.Enum, "|"))
+	}
+	if !param.Required {
+		details = append(details, "optional")
+	}
+	if param.Description != "" {
+		details = append(details, param.Description)
+	}
+	return fmt.Sprintf("%s (%s)", name, strings.Join(details, ", "))
+}
+
+// bodyValue converts a value entered for a body parameter: strings, dates
+// and enums are kept as is, other values are read as JSON when valid.
+func bodyValue(api *schema.API, param *schema.Parameter, value string) interface{} {
+	switch param.FullType {
+	case "string", "password", "text", "date", "datetime", "time", "ipv4", "ipv6", "ip", "ipBlock", "ipv4Block", "ipv6Block":
+		return value
+	}
+	if model := api.Model(param.FullType); model != nil && model.IsEnum() {
+		return value
+	}
+	if json.Valid([]byte(value)) {
+		return json.RawMessage(value)
+	}
+	return value
+}
+
+// showRequest is a middleware writing the signed requests to the console
+// output. Credentials are redacted, as the output may be shared when
+// debugging.
+func (c *console) showRequest(next govh.RoundTripFunc) govh.RoundTripFunc {
+	return func(request *http.Request) (*http.Response, error) {
+		fmt.Fprintf(c.out, "> %s %s\n", request.Method, request.URL)
+
+		names := make([]string, 0, len(request.Header))
+		for name := range request.Header {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(c.out, "> %s: %s\n", name, govh.RedactHeader(name, request.Header.Get(name)))
+		}
+
+		if request.GetBody != nil {
+			if body, err := request.GetBody(); err == nil {
+				var buf strings.Builder
+				io.Copy(&buf, body)
+				if buf.Len() > 0 {
+					fmt.Fprintf(c.out, "> %s\n", govh.RedactBody(buf.String()))
+				}
+			}
+		}
+		return next(request)
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+	"github.com/garbage-collector/ovh-go/schema"
+)
+
+const consoleSchema = `{
+  "apiVersion": "1.0",
+  "resourcePath": "/domain",
+  "apis": [
+    {"path": "/domain/zone/{zoneName}", "operations": [
+      {"httpMethod": "GET", "description": "Get this object properties", "responseType": "domain.zone.Zone", "parameters": [
+        {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true}
+      ]}
+    ]},
+    {"path": "/domain/zone/{zoneName}/record", "operations": [
+      {"httpMethod": "POST", "description": "Create a new DNS record", "responseType": "domain.zone.Record", "parameters": [
+        {"name": "fieldType", "paramType": "body", "dataType": "zone.NamedResolutionFieldTypeEnum", "fullType": "zone.NamedResolutionFieldTypeEnum", "required": true},
+        {"name": "target", "paramType": "body", "dataType": "string", "fullType": "string", "required": true},
+        {"name": "ttl", "paramType": "body", "dataType": "long", "fullType": "long", "required": false},
+        {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true}
+      ]}
+    ]}
+  ],
+  "models": {
+    "zone.NamedResolutionFieldTypeEnum": {"id": "NamedResolutionFieldTypeEnum", "namespace": "zone", "enumType": "string", "enum": ["A", "AAAA", "CNAME"]}
+  }
+}`
+
+func TestConsole(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain/zone/example.com", 200, map[string]interface{}{"name": "example.com", "hasDnsAnycast": false})
+	server.HandleError("GET /domain/zone/forbidden.com", 403, "Client::Forbidden", "This call has not been granted")
+
+	var posted map[string]interface{}
+	server.HandleFunc("POST /domain/zone/example.com/record", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &posted)
+		w.Write([]byte(`{"id":1,"fieldType":"A"}`))
+	})
+
+	input := strings.Join([]string{
+		"use domain",
+		"routes /domain/zone/{zoneName}/",
+		"get /domain/zone/{zoneName}",
+		"example.com",
+		"post /domain/zone/example.com/record",
+		"A",
+		"192.0.2.1",
+		"3600",
+		"get /domain/zone/forbidden.com",
+		"exit",
+	}, "\n")
+	var out bytes.Buffer
+	c := newConsole(server.Caller(), strings.NewReader(input), &out)
+	c.index = func(ctx context.Context) (*schema.Index, error) {
+		return &schema.Index{APIs: []*schema.IndexEntry{{Path: "/domain"}}}, nil
+	}
+	c.api = func(ctx context.Context, name string) (*schema.API, error) {
+		if name != "domain" {
+			t.Fatalf("Unexpected API %s", name)
+		}
+		return schema.Parse(strings.NewReader(consoleSchema))
+	}
+
+	if err := c.run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	output := out.String()
+	for _, expected := range []string{
+		"POST   /domain/zone/{zoneName}/record\n       Create a new DNS record\n",
+		"ovh/domain> zoneName: ",
+		"> GET " + server.URL + "/domain/zone/example.com\n",
+		"> X-Ovh-Signature: REDACTED\n",
+		"> X-Ovh-Consumer: REDACTED\n",
+		"< 200 OK\n{\n  \"hasDnsAnycast\": false,\n  \"name\": \"example.com\"\n}\n",
+		"fieldType (zone.NamedResolutionFieldTypeEnum, A|AAAA|CNAME): ",
+		"ttl (long, optional): ",
+		`> {"fieldType":"A","target":"192.0.2.1","ttl":3600}`,
+		"< 403 Forbidden\n",
+		"The access rules of the consumer key may not allow GET /domain/zone/{zoneName}.\n",
+		"Error: ",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Fatalf("Expected %q in output:\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, govhtest.ConsumerKey) {
+		t.Fatalf("The consumer key was shown:\n%s", output)
+	}
+	if posted["ttl"] != 3600.0 || posted["target"] != "192.0.2.1" {
+		t.Fatalf("Unexpected body %v", posted)
+	}
+}
@@ -0,0 +1,244 @@
+// Command ovh calls the OVH API from the command line:
+//
+//	ovh login
+//	ovh get /me
+//	ovh -o table get /domain/zone
+//	ovh post /domain/zone/example.com/refresh
+//	ovh console
+//	echo '{"fieldType":"A","target":"192.0.2.1"}' | ovh post /domain/zone/example.com/record -
+//
+// Credentials are read from the OVH_* environment variables, or else from
+// the ovh.conf files shared with the other OVH SDKs.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+const usage = `Usage: ovh [flags] <command> [arguments]
+
+Commands:
+  get PATH             call GET PATH
+  post PATH [BODY]     call POST PATH with a JSON body, read from stdin if BODY is -
+  put PATH [BODY]      call PUT PATH with a JSON body, read from stdin if BODY is -
+  delete PATH          call DELETE PATH
+  login                request a consumer key, and wait for its validation
+  console              explore the API interactively
+  completion SHELL     print the completion script of SHELL (bash or zsh)
+
+Flags:
+`
+
+// queryFlag collects -q key=value query parameters.
+type queryFlag url.Values
+
+func (query queryFlag) String() string {
+	return url.Values(query).Encode()
+}
+
+func (query queryFlag) Set(s string) error {
+	i := strings.IndexByte(s, '=')
+	if i < 0 {
+		return fmt.Errorf("expected key=value, got %q", s)
+	}
+	url.Values(query).Add(s[:i], s[i+1:])
+	return nil
+}
+
+// options are the global flags of the command.
+type options struct {
+	endpoint string
+	config   string
+	output   string
+	query    queryFlag
+}
+
+func main() {
+	opts := &options{query: queryFlag{}}
+	flags := flag.NewFlagSet("ovh", flag.ExitOnError)
+	flags.StringVar(&opts.endpoint, "endpoint", "", "API endpoint, e.g. ovh-eu, overriding the configuration")
+	flags.StringVar(&opts.config, "config", "", "configuration file, instead of the default ovh.conf files")
+	flags.StringVar(&opts.output, "o", "json", "output format: json, table or raw")
+	flags.Var(opts.query, "q", "query parameter key=value, may be repeated")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(os.Args[1:])
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, opts, flags.Arg(0), flags.Args()[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "ovh:", err)
+		os.Exit(1)
+	}
+}
+
+// run runs a command.
+func run(ctx context.Context, opts *options, command string, args []string) error {
+	switch command {
+	case "get", "post", "put", "delete":
+		return call(ctx, opts, strings.ToUpper(command), args)
+	case "login":
+		return login(ctx, opts, args)
+	case "console":
+		return runConsole(ctx, opts, args)
+	case "completion":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: ovh completion bash|zsh")
+		}
+		return printCompletion(os.Stdout, args[0])
+	case "__complete":
+		return complete(ctx, opts, os.Stdout, args)
+	}
+	return fmt.Errorf("unknown command %q, see ovh -h", command)
+}
+
+// call performs a call, and prints its response.
+func call(ctx context.Context, opts *options, method string, args []string) error {
+	withBody := method == "POST" || method == "PUT"
+	if len(args) < 1 || len(args) > 2 || (len(args) == 2 && !withBody) {
+		if withBody {
+			return fmt.Errorf("usage: ovh %s PATH [BODY]", strings.ToLower(method))
+		}
+		return fmt.Errorf("usage: ovh %s PATH", strings.ToLower(method))
+	}
+	path := args[0]
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	var body interface{}
+	if len(args) == 2 {
+		data := []byte(args[1])
+		if args[1] == "-" {
+			var err error
+			if data, err = ioutil.ReadAll(os.Stdin); err != nil {
+				return err
+			}
+		}
+		if !json.Valid(data) {
+			return fmt.Errorf("body is not valid JSON")
+		}
+		body = json.RawMessage(data)
+	}
+
+	caller, err := newCaller(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	response, err := caller.CallAPIRaw(ctx, path, method, body, nil, govh.WithQuery(url.Values(opts.query)))
+	if err != nil {
+		return err
+	}
+	return printResponse(os.Stdout, opts.output, response.Body)
+}
+
+// loadConfig reads the configuration from the environment, or else from the
+// configuration files.
+func loadConfig(opts *options) (*govh.Config, error) {
+	config, envErr := govh.LoadEnvConfig()
+	if envErr != nil || opts.config != "" {
+		var paths []string
+		if opts.config != "" {
+			paths = []string{opts.config}
+		}
+
+		var err error
+		config, err = govh.LoadEndpointConfig(opts.endpoint, paths...)
+		if err != nil {
+			return nil, fmt.Errorf("no credentials found: %s, and %s", envErr, err)
+		}
+	}
+
+	// Configuration files resolve the endpoint of aliases themselves.
+	if envErr == nil && opts.config == "" && opts.endpoint != "" {
+		config.Endpoint = opts.endpoint
+	}
+	return config, nil
+}
+
+// newCaller creates a caller from the configuration.
+func newCaller(ctx context.Context, opts *options) (*govh.Caller, error) {
+	config, err := loadConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	options := append(config.Options(), govh.WithUserAgent("ovh-cli", govh.Version), govh.WithoutTimeSync())
+	return govh.NewClientWithContext(ctx, config.Endpoint, options...)
+}
+
+// login requests a consumer key with the access rules given as arguments,
+// such as "GET /me" or "ALL /domain/*", all rules by default.
+func login(ctx context.Context, opts *options, args []string) error {
+	flags := flag.NewFlagSet("login", flag.ExitOnError)
+	noBrowser := flags.Bool("no-browser", false, "print the validation URL instead of opening it")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: ovh login [-no-browser] [METHOD PATH]...")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	rules, err := parseRules(flags.Args())
+	if err != nil {
+		return err
+	}
+
+	caller, err := newCaller(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	display := govh.OpenValidationURL(os.Stderr)
+	if *noBrowser {
+		display = govh.PrintValidationURL(os.Stderr)
+	}
+	consumerKey, err := caller.Login(ctx, &govh.GetCKParams{AccessRules: rules}, display)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stderr, "Consumer key validated, add it to your ovh.conf or environment:")
+	fmt.Fprintf(os.Stdout, "consumer_key=%s\n", consumerKey)
+	return nil
+}
+
+// parseRules parses access rules given as METHOD PATH pairs, such as
+// "GET /me" or "ALL /domain/*".
+func parseRules(args []string) ([]*govh.AccessRule, error) {
+	if len(args) == 0 {
+		return govh.AllRules(), nil
+	}
+	if len(args)%2 != 0 {
+		return nil, fmt.Errorf("access rules must be given as METHOD PATH pairs")
+	}
+
+	rules := govh.NewRuleSet()
+	for i := 0; i < len(args); i += 2 {
+		method := strings.ToUpper(args[i])
+		if method == "ALL" {
+			rules.ReadWrite(args[i+1])
+		} else {
+			rules.Add(method, args[i+1])
+		}
+	}
+	return rules.Build()
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+func TestLoadConfig(t *testing.T) {
+	for _, name := range []string{govh.EnvEndpoint, govh.EnvApplicationKey, govh.EnvApplicationSecret, govh.EnvConsumerKey, govh.EnvClientID, govh.EnvClientSecret} {
+		if value, ok := os.LookupEnv(name); ok {
+			defer os.Setenv(name, value)
+		} else {
+			defer os.Unsetenv(name)
+		}
+		os.Unsetenv(name)
+	}
+
+	dir, err := ioutil.TempDir("", "ovh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "ovh.conf")
+	ioutil.WriteFile(path, []byte(`
+[work]
+endpoint=ovh-ca
+application_key=ak
+application_secret=as
+consumer_key=ck
+`), 0600)
+
+	config, err := loadConfig(&options{endpoint: "work", config: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Endpoint != "ovh-ca" || config.ApplicationKey != "ak" {
+		t.Fatalf("unexpected configuration %+v", config)
+	}
+
+	os.Setenv(govh.EnvEndpoint, "ovh-eu")
+	os.Setenv(govh.EnvApplicationKey, "ak")
+	os.Setenv(govh.EnvApplicationSecret, "as")
+	config, err = loadConfig(&options{endpoint: "ovh-us"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Endpoint != "ovh-us" {
+		t.Fatalf("expected the endpoint flag to override the environment, got %q", config.Endpoint)
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// printResponse writes a response body to w in the given format:
+//   - json: indented JSON,
+//   - table: a row per item for arrays, a row per field for objects,
+//   - raw: the body as received.
+func printResponse(w io.Writer, format string, body []byte) error {
+	switch format {
+	case "raw":
+		_, err := w.Write(body)
+		return err
+
+	case "json":
+		body = bytes.TrimSpace(body)
+		if len(body) == 0 {
+			return nil
+		}
+		var out bytes.Buffer
+		if err := json.Indent(&out, body, "", "  "); err != nil {
+			// Not JSON: print it as is.
+			out.Reset()
+			out.Write(body)
+		}
+		out.WriteByte('\n')
+		_, err := out.WriteTo(w)
+		return err
+
+	case "table":
+		return printTable(w, body)
+	}
+	return fmt.Errorf("unknown output format %q, expected json, table or raw", format)
+}
+
+// printTable writes a JSON body as a table.
+func printTable(w io.Writer, body []byte) error {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		_, err := w.Write(body)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	switch value := value.(type) {
+	case []interface{}:
+		columns := tableColumns(value)
+		if columns == nil {
+			for _, item := range value {
+				fmt.Fprintln(tw, cell(item))
+			}
+			break
+		}
+
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(columns, "\t")))
+		for _, item := range value {
+			object, _ := item.(map[string]interface{})
+			row := make([]string, len(columns))
+			for i, column := range columns {
+				row[i] = cell(object[column])
+			}
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(tw, "%s\t%s\n", key, cell(value[key]))
+		}
+
+	default:
+		fmt.Fprintln(tw, cell(value))
+	}
+	return tw.Flush()
+}
+
+// tableColumns returns the sorted union of the keys of items, or nil if
+// some items are not objects.
+func tableColumns(items []interface{}) []string {
+	seen := map[string]bool{}
+	columns := []string{}
+	for _, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		for key := range object {
+			if !seen[key] {
+				seen[key] = true
+				columns = append(columns, key)
+			}
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// cell formats a value for a table cell: scalars as is, other values as
+// compact JSON.
+func cell(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "-"
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case bool:
+		return fmt.Sprint(value)
+	}
+	data, _ := json.Marshal(value)
+	return string(data)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrintResponse(t *testing.T) {
+	tests := []struct {
+		format, body, expected string
+	}{
+		{"json", `{"a":1,"b":[true]}`, "{\n  \"a\": 1,\n  \"b\": [\n    true\n  ]\n}\n"},
+		{"json", `plain text`, "plain text\n"},
+		{"json", ``, ""},
+		{"raw", `{"a":1}`, `{"a":1}`},
+		{"table", `["a","b"]`, "a\nb\n"},
+		{"table", `{"nichandle":"xx1234-ovh","state":"complete","currency":{"code":"EUR"}}`,
+			"currency   {\"code\":\"EUR\"}\nnichandle  xx1234-ovh\nstate      complete\n"},
+		{"table", `[{"id":1,"name":"www"},{"id":12345678901,"ttl":null}]`,
+			"ID           NAME  TTL\n1            www   -\n12345678901  -     -\n"},
+	}
+	for _, test := range tests {
+		var out bytes.Buffer
+		if err := printResponse(&out, test.format, []byte(test.body)); err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != test.expected {
+			t.Fatalf("%s %s: expected %q, got %q", test.format, test.body, test.expected, out.String())
+		}
+	}
+
+	if err := printResponse(&bytes.Buffer{}, "yaml", nil); err == nil {
+		t.Fatal("Expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,59 @@
+package govh
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// acceptEncoding is the Accept-Encoding header sent when compression is
+// enabled.
+const acceptEncoding = "gzip, deflate"
+
+// decompressedBody closes both the decompressing reader and the underlying
+// response body.
+type decompressedBody struct {
+	io.Reader
+	decompressor io.Closer
+	body         io.Closer
+}
+
+func (body *decompressedBody) Close() error {
+	body.decompressor.Close()
+	return body.body.Close()
+}
+
+// decompress replaces the body of a compressed response by its decompressed
+// content.
+func decompress(result *http.Response) error {
+	var (
+		reader io.ReadCloser
+		err    error
+	)
+	switch strings.ToLower(strings.TrimSpace(result.Header.Get("Content-Encoding"))) {
+	case "gzip":
+		reader, err = gzip.NewReader(result.Body)
+	case "deflate":
+		reader, err = zlib.NewReader(result.Body)
+	default:
+		return nil
+	}
+	if err == io.EOF {
+		// Empty body
+		return nil
+	}
+	if err != nil {
+		result.Body.Close()
+		return err
+	}
+
+	result.Body = &decompressedBody{Reader: reader, decompressor: reader, body: result.Body}
+	result.Header.Del("Content-Encoding")
+	result.Header.Del("Content-Length")
+	result.ContentLength = -1
+	result.Uncompressed = true
+
+	return nil
+}
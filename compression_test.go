@@ -0,0 +1,41 @@
+package govh
+
+import (
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCompressedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != acceptEncoding {
+			w.Write([]byte(`["plain"]`))
+			return
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(`["compressed"]`))
+		gz.Close()
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+
+	var zones []string
+	if err := c.CallAPI("/domain/zone", "GET", nil, &zones); err != nil {
+		t.Fatal(err)
+	}
+	if len(zones) != 1 || zones[0] != "compressed" {
+		t.Fatalf("unexpected response %v", zones)
+	}
+
+	c.DisableCompression = true
+	if err := c.CallAPI("/domain/zone", "GET", nil, &zones); err != nil {
+		t.Fatal(err)
+	}
+	if len(zones) != 1 || zones[0] != "plain" {
+		t.Fatalf("unexpected response %v", zones)
+	}
+}
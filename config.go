@@ -0,0 +1,169 @@
+package govh
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ConfigPaths are the configuration files read by NewDefaultCaller, in order:
+// values of later files override earlier ones. This is the same lookup as the
+// other OVH SDKs, so that credentials can be shared with them.
+var ConfigPaths = []string{
+	"/etc/ovh.conf",
+	"~/.ovh.conf",
+	"./ovh.conf",
+}
+
+// Config holds the settings read from ovh.conf files.
+//
+//	[default]
+//	endpoint=ovh-eu
+//
+//	[ovh-eu]
+//	application_key=my_app_key
+//	application_secret=my_application_secret
+//	consumer_key=my_consumer_key
+type Config struct {
+	Endpoint          string
+	ApplicationKey    string
+	ApplicationSecret string
+	ConsumerKey       string
+	// OAuth2 client credentials, used instead of the application
+	// credentials when set.
+	ClientID     string
+	ClientSecret string
+}
+
+// LoadConfig reads configuration files, ConfigPaths if none is given.
+// Missing files are skipped. The endpoint is read from the [default] section,
+// and the credentials from the section named after the endpoint.
+func LoadConfig(paths ...string) (*Config, error) {
+	return LoadEndpointConfig("", paths...)
+}
+
+// LoadEndpointConfig is like LoadConfig, but reads the credentials of the
+// given endpoint instead of the default one. A section may also be named
+// after an account alias, and set the actual endpoint with an endpoint key.
+func LoadEndpointConfig(endpoint string, paths ...string) (*Config, error) {
+	if len(paths) == 0 {
+		paths = ConfigPaths
+	}
+
+	sections := map[string]map[string]string{}
+	for _, path := range paths {
+		if err := readConfigFile(expandHome(path), sections); err != nil {
+			return nil, err
+		}
+	}
+
+	if endpoint == "" {
+		endpoint = sections["default"]["endpoint"]
+	}
+	if endpoint == "" {
+		return nil, fmt.Errorf("No endpoint found in configuration files %s", strings.Join(paths, ", "))
+	}
+
+	section := sections[endpoint]
+	if section["endpoint"] != "" {
+		endpoint = section["endpoint"]
+	}
+	return &Config{
+		Endpoint:          endpoint,
+		ApplicationKey:    section["application_key"],
+		ApplicationSecret: section["application_secret"],
+		ConsumerKey:       section["consumer_key"],
+		ClientID:          section["client_id"],
+		ClientSecret:      section["client_secret"],
+	}, nil
+}
+
+// Options returns the options building a caller from the configuration.
+func (config *Config) Options() []Option {
+	if config.ClientID != "" {
+		return []Option{WithOAuth2(config.ClientID, config.ClientSecret)}
+	}
+	return []Option{WithCredentials(config.ApplicationKey, config.ApplicationSecret, config.ConsumerKey)}
+}
+
+// NewCallerFromConfig creates a caller from configuration files, ConfigPaths
+// if none is given. See LoadConfig.
+func NewCallerFromConfig(paths ...string) (*Caller, error) {
+	config, err := LoadConfig(paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(config.Endpoint, config.Options()...)
+}
+
+// NewDefaultCaller creates a caller from the default configuration files.
+func NewDefaultCaller() (*Caller, error) {
+	return NewCallerFromConfig()
+}
+
+// readConfigFile merges the sections of an INI file into sections.
+// It does nothing if the file doesn't exist.
+func readConfigFile(path string, sections map[string]map[string]string) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := parseINI(f, sections); err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+	return nil
+}
+
+// parseINI merges the sections of an INI document into sections.
+func parseINI(r io.Reader, sections map[string]map[string]string) error {
+	section := ""
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == ';' || line[0] == '#' {
+			continue
+		}
+
+		if line[0] == '[' {
+			if line[len(line)-1] != ']' {
+				return fmt.Errorf("line %d: invalid section %q", n, line)
+			}
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+
+		i := strings.IndexAny(line, "=:")
+		if i < 0 {
+			return fmt.Errorf("line %d: expected key=value, got %q", n, line)
+		}
+		if sections[section] == nil {
+			sections[section] = map[string]string{}
+		}
+		key := strings.ToLower(strings.TrimSpace(line[:i]))
+		sections[section][key] = strings.TrimSpace(line[i+1:])
+	}
+
+	return scanner.Err()
+}
+
+// expandHome replaces a leading ~ by the user home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
@@ -0,0 +1,56 @@
+package govh
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "govh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	global := filepath.Join(dir, "global.conf")
+	ioutil.WriteFile(global, []byte(`
+; shared by all users
+[default]
+endpoint=ovh-eu
+
+[ovh-eu]
+application_key=globalak
+application_secret=globalas
+`), 0600)
+
+	local := filepath.Join(dir, "local.conf")
+	ioutil.WriteFile(local, []byte(`
+[ovh-eu]
+consumer_key = localck
+application_key = localak
+
+[ovh-ca]
+application_key=caak
+`), 0600)
+
+	config, err := LoadConfig(global, filepath.Join(dir, "missing.conf"), local)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Config{Endpoint: "ovh-eu", ApplicationKey: "localak", ApplicationSecret: "globalas", ConsumerKey: "localck"}
+	if *config != expected {
+		t.Fatalf("unexpected config %+v", config)
+	}
+
+	config, err = LoadEndpointConfig("ovh-ca", global, local)
+	if err != nil || config.ApplicationKey != "caak" {
+		t.Fatalf("unexpected config %+v (%v)", config, err)
+	}
+
+	if _, err := LoadConfig(local); err == nil {
+		t.Fatal("expected an error without endpoint")
+	}
+}
@@ -0,0 +1,208 @@
+package govh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Credentials are the keys used to sign requests.
+type Credentials struct {
+	ApplicationKey    string
+	ApplicationSecret string
+	ConsumerKey       string
+	// When the credentials must be fetched again from their provider.
+	// They never expire when zero.
+	Expires time.Time
+}
+
+// expired tells whether credentials must be refreshed.
+func (credentials *Credentials) expired() bool {
+	return !credentials.Expires.IsZero() && !time.Now().Before(credentials.Expires)
+}
+
+// CredentialsProvider supplies the credentials signing requests, e.g. from a
+// secret manager, so that they can be rotated without recreating the caller.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (*Credentials, error)
+}
+
+// CredentialsFunc adapts a function to a CredentialsProvider.
+type CredentialsFunc func(ctx context.Context) (*Credentials, error)
+
+// Credentials implements CredentialsProvider.
+func (fn CredentialsFunc) Credentials(ctx context.Context) (*Credentials, error) {
+	return fn(ctx)
+}
+
+// StaticCredentials returns a provider always supplying the given keys.
+func StaticCredentials(applicationKey, applicationSecret, consumerKey string) CredentialsProvider {
+	credentials := &Credentials{
+		ApplicationKey:    applicationKey,
+		ApplicationSecret: applicationSecret,
+		ConsumerKey:       consumerKey,
+	}
+	return CredentialsFunc(func(ctx context.Context) (*Credentials, error) {
+		return credentials, nil
+	})
+}
+
+// EnvCredentials returns a provider reading the OVH_* environment variables.
+// See LoadEnvConfig.
+func EnvCredentials() CredentialsProvider {
+	return CredentialsFunc(func(ctx context.Context) (*Credentials, error) {
+		config, err := LoadEnvConfig()
+		if err != nil {
+			return nil, err
+		}
+		return config.credentials(), nil
+	})
+}
+
+// ConfigFileCredentials returns a provider reading configuration files,
+// ConfigPaths if none is given. See LoadConfig.
+func ConfigFileCredentials(paths ...string) CredentialsProvider {
+	return CredentialsFunc(func(ctx context.Context) (*Credentials, error) {
+		config, err := LoadConfig(paths...)
+		if err != nil {
+			return nil, err
+		}
+		return config.credentials(), nil
+	})
+}
+
+// ChainCredentials returns a provider trying each of providers in turn, and
+// supplying the credentials of the first one which succeeds.
+func ChainCredentials(providers ...CredentialsProvider) CredentialsProvider {
+	return CredentialsFunc(func(ctx context.Context) (*Credentials, error) {
+		var errs []string
+		for _, provider := range providers {
+			credentials, err := provider.Credentials(ctx)
+			if err == nil {
+				return credentials, nil
+			}
+			errs = append(errs, err.Error())
+		}
+		return nil, fmt.Errorf("No credentials found: %s", strings.Join(errs, "; "))
+	})
+}
+
+func (config *Config) credentials() *Credentials {
+	return &Credentials{
+		ApplicationKey:    config.ApplicationKey,
+		ApplicationSecret: config.ApplicationSecret,
+		ConsumerKey:       config.ConsumerKey,
+	}
+}
+
+// CredentialsAuth signs requests with credentials supplied by a provider.
+// Credentials are cached until they expire, or until Invalidate is called.
+type CredentialsAuth struct {
+	provider CredentialsProvider
+
+	mu     sync.Mutex
+	cached *Credentials
+}
+
+// NewCredentialsAuth creates an AuthProvider signing requests with the
+// credentials supplied by provider.
+func NewCredentialsAuth(provider CredentialsProvider) *CredentialsAuth {
+	return &CredentialsAuth{provider: provider}
+}
+
+// Credentials returns the current credentials, fetching them if needed.
+func (auth *CredentialsAuth) Credentials(ctx context.Context) (*Credentials, error) {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+
+	if auth.cached != nil && !auth.cached.expired() {
+		return auth.cached, nil
+	}
+
+	credentials, err := auth.provider.Credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	auth.cached = credentials
+
+	return credentials, nil
+}
+
+// Invalidate forces credentials to be fetched again before the next request.
+func (auth *CredentialsAuth) Invalidate() {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+
+	auth.cached = nil
+}
+
+// SignRequest implements AuthProvider.
+func (auth *CredentialsAuth) SignRequest(request *http.Request, body []byte, now time.Time) error {
+	credentials, err := auth.Credentials(request.Context())
+	if err != nil {
+		return err
+	}
+
+	signature := &SignatureAuth{
+		ApplicationKey:    credentials.ApplicationKey,
+		ApplicationSecret: credentials.ApplicationSecret,
+		ConsumerKey:       credentials.ConsumerKey,
+	}
+	return signature.SignRequest(request, body, now)
+}
+
+// ClockSensitive implements ClockSensitive.
+func (auth *CredentialsAuth) ClockSensitive() bool {
+	return true
+}
+
+// WithoutConsumerKey implements ConsumerKeyOmitter.
+func (auth *CredentialsAuth) WithoutConsumerKey() AuthProvider {
+	return &credentialsWithoutConsumerKey{auth: auth}
+}
+
+// credentialsWithoutConsumerKey signs requests with the application keys of
+// a CredentialsAuth, without its consumer key.
+type credentialsWithoutConsumerKey struct {
+	auth *CredentialsAuth
+}
+
+// SignRequest implements AuthProvider.
+func (auth *credentialsWithoutConsumerKey) SignRequest(request *http.Request, body []byte, now time.Time) error {
+	credentials, err := auth.auth.Credentials(request.Context())
+	if err != nil {
+		return err
+	}
+
+	signature := &SignatureAuth{
+		ApplicationKey:    credentials.ApplicationKey,
+		ApplicationSecret: credentials.ApplicationSecret,
+	}
+	return signature.SignRequest(request, body, now)
+}
+
+// ClockSensitive implements ClockSensitive.
+func (auth *credentialsWithoutConsumerKey) ClockSensitive() bool {
+	return true
+}
+
+// Secrets implements SecretHolder. Only the cached credentials are returned,
+// without fetching them.
+func (auth *CredentialsAuth) Secrets() []string {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+
+	if auth.cached == nil {
+		return nil
+	}
+	return []string{auth.cached.ApplicationSecret, auth.cached.ConsumerKey}
+}
+
+// WithCredentialsProvider signs requests with the credentials supplied by
+// provider, instead of fixed keys.
+func WithCredentialsProvider(provider CredentialsProvider) Option {
+	return WithAuthProvider(NewCredentialsAuth(provider))
+}
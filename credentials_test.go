@@ -0,0 +1,50 @@
+package govh
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCredentialsProvider(t *testing.T) {
+	var consumers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		consumers = append(consumers, r.Header.Get("X-Ovh-Consumer"))
+	}))
+	defer server.Close()
+
+	// Simulate a secret manager rotating the consumer key.
+	rotation := 0
+	vault := CredentialsFunc(func(ctx context.Context) (*Credentials, error) {
+		rotation++
+		return &Credentials{
+			ApplicationKey:    "ak",
+			ApplicationSecret: "as",
+			ConsumerKey:       []string{"", "ck1", "ck2"}[rotation],
+			Expires:           time.Now().Add(time.Hour),
+		}, nil
+	})
+	failing := CredentialsFunc(func(ctx context.Context) (*Credentials, error) {
+		return nil, errors.New("not configured")
+	})
+
+	auth := NewCredentialsAuth(ChainCredentials(failing, vault))
+	c := &Caller{URL: server.URL, Auth: auth}
+
+	for i := 0; i < 2; i++ {
+		if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	auth.Invalidate()
+	if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(consumers) != 3 || consumers[0] != "ck1" || consumers[1] != "ck1" || consumers[2] != "ck2" {
+		t.Fatalf("unexpected consumer keys %v", consumers)
+	}
+}
@@ -0,0 +1,63 @@
+package govh
+
+import (
+	"context"
+	"time"
+)
+
+// CurrentCredential describes the consumer key used by a caller, as returned
+// by GET /auth/currentCredential.
+type CurrentCredential struct {
+	// Identifier of the consumer key.
+	CredentialID int64 `json:"credentialId"`
+	// Identifier of the application owning the consumer key.
+	ApplicationID int64 `json:"applicationId"`
+	// Status of the consumer key: pendingValidation, validated, expired or refused.
+	Status string `json:"status"`
+	// Calls allowed for the consumer key.
+	Rules []*AccessRule `json:"rules"`
+	// IP blocks allowed to use the consumer key.
+	AllowedIPs []string `json:"allowedIPs"`
+	// Whether the consumer key was created for OVH support.
+	OvhSupport bool `json:"ovhSupport"`
+	// Creation, expiration and last use dates.
+	Creation   time.Time  `json:"creation"`
+	Expiration *time.Time `json:"expiration"`
+	LastUse    *time.Time `json:"lastUse"`
+}
+
+// AuthDetails describes the account and identity behind a caller's
+// credentials, as returned by GET /auth/details.
+type AuthDetails struct {
+	// Account (NIC handle) the credentials act on.
+	Account string `json:"account"`
+	// Description of the credentials.
+	Description string `json:"description"`
+	// Authentication method, e.g. "account" or "user".
+	Method string `json:"method"`
+	// Sub-user name, when authenticated as a user of the account.
+	User string `json:"user"`
+	// IAM identities and roles of the credentials.
+	Identities []string `json:"identities"`
+	Roles      []string `json:"roles"`
+}
+
+// GetCurrentCredential returns the details of the consumer key used by the
+// caller, e.g. to check its access rules when diagnosing a 403 error.
+func (caller *Caller) GetCurrentCredential(ctx context.Context) (*CurrentCredential, error) {
+	credential := &CurrentCredential{}
+	if err := caller.CallAPIWithContext(ctx, "/auth/currentCredential", "GET", nil, credential); err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+// GetAuthDetails returns the account and identity behind the caller's
+// credentials.
+func (caller *Caller) GetAuthDetails(ctx context.Context) (*AuthDetails, error) {
+	details := &AuthDetails{}
+	if err := caller.CallAPIWithContext(ctx, "/auth/details", "GET", nil, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
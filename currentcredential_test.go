@@ -0,0 +1,49 @@
+package govh
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetCurrentCredential(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/currentCredential":
+			w.Write([]byte(`{
+				"credentialId": 123456,
+				"applicationId": 42,
+				"status": "validated",
+				"rules": [{"method": "GET", "path": "/me"}],
+				"allowedIPs": null,
+				"ovhSupport": false,
+				"creation": "2024-03-01T10:00:00+01:00",
+				"expiration": "2024-03-02T10:00:00+01:00",
+				"lastUse": null
+			}`))
+		case "/auth/details":
+			w.Write([]byte(`{"account":"xx1234-ovh","method":"account","identities":["urn:v1:eu:identity:account:xx1234-ovh"],"roles":["ADMIN"]}`))
+		}
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+
+	credential, err := c.GetCurrentCredential(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credential.CredentialID != 123456 || credential.Status != "validated" || len(credential.Rules) != 1 ||
+		credential.Expiration == nil || credential.LastUse != nil {
+		t.Fatalf("unexpected credential %+v", credential)
+	}
+
+	details, err := c.GetAuthDetails(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.Account != "xx1234-ovh" || len(details.Roles) != 1 {
+		t.Fatalf("unexpected details %+v", details)
+	}
+}
@@ -0,0 +1,124 @@
+package govh
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Date is a day, such as the expiration date of a service, encoded by the API
+// as "2006-01-02". It also decodes timestamps, keeping their date, and null,
+// leaving the zero value. The zero value is encoded as null.
+type Date struct {
+	time.Time
+}
+
+// NewDate returns the date of the given day, in UTC.
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// dateLayout is the layout of dates in the API.
+const dateLayout = "2006-01-02"
+
+// String returns the date formatted as "2006-01-02".
+func (date Date) String() string {
+	return date.Format(dateLayout)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (date Date) MarshalJSON() ([]byte, error) {
+	if date.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + date.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (date *Date) UnmarshalJSON(data []byte) error {
+	s, ok, err := unquoteTime(data)
+	if err != nil || !ok {
+		*date = Date{}
+		return err
+	}
+
+	if t, err := time.Parse(dateLayout, s); err == nil {
+		*date = Date{t}
+		return nil
+	}
+	t, err := parseDateTime(s)
+	if err != nil {
+		return fmt.Errorf("Invalid date %q", s)
+	}
+	*date = NewDate(t.Date())
+	return nil
+}
+
+// DateTime is an instant, such as the creation time of a resource, encoded by
+// the API as RFC 3339 timestamps, with or without fractional seconds. It also
+// decodes dates, as midnight UTC, and null, leaving the zero value. The zero
+// value is encoded as null.
+type DateTime struct {
+	time.Time
+}
+
+// MarshalJSON implements json.Marshaler.
+func (dateTime DateTime) MarshalJSON() ([]byte, error) {
+	if dateTime.IsZero() {
+		return []byte("null"), nil
+	}
+	return dateTime.Time.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (dateTime *DateTime) UnmarshalJSON(data []byte) error {
+	s, ok, err := unquoteTime(data)
+	if err != nil || !ok {
+		*dateTime = DateTime{}
+		return err
+	}
+
+	t, err := parseDateTime(s)
+	if err != nil {
+		t, err = time.Parse(dateLayout, s)
+	}
+	if err != nil {
+		return fmt.Errorf("Invalid date and time %q", s)
+	}
+	*dateTime = DateTime{t}
+	return nil
+}
+
+// dateTimeLayouts are the layouts of timestamps in the API: mostly RFC 3339,
+// sometimes with the offset missing its colon, or without offset, in UTC.
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05",
+}
+
+func parseDateTime(s string) (time.Time, error) {
+	var err error
+	for _, layout := range dateTimeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// unquoteTime returns the string of a JSON date, and false for null or an
+// empty string.
+func unquoteTime(data []byte) (string, bool, error) {
+	if bytes.Equal(data, []byte("null")) {
+		return "", false, nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", false, fmt.Errorf("Invalid date %s", data)
+	}
+	return s, s != "", nil
+}
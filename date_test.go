@@ -0,0 +1,76 @@
+package govh
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDate(t *testing.T) {
+	for input, expected := range map[string]string{
+		`"2024-03-01"`:                "2024-03-01",
+		`"2024-03-01T00:30:00+01:00"`: "2024-03-01",
+		`"2024-03-01T23:30:00Z"`:      "2024-03-01",
+		`null`:                        "0001-01-01",
+		`""`:                          "0001-01-01",
+	} {
+		var date Date
+		if err := json.Unmarshal([]byte(input), &date); err != nil {
+			t.Fatalf("%s: %s", input, err)
+		}
+		if date.String() != expected {
+			t.Fatalf("%s: got %s, expected %s", input, date, expected)
+		}
+	}
+
+	for _, input := range []string{`"01/03/2024"`, `42`, `{}`} {
+		var date Date
+		if err := json.Unmarshal([]byte(input), &date); err == nil {
+			t.Fatalf("%s: expected an error", input)
+		}
+	}
+
+	data, err := json.Marshal(struct {
+		Set   Date `json:"set"`
+		Unset Date `json:"unset"`
+	}{Set: NewDate(2024, time.March, 1)})
+	if err != nil || string(data) != `{"set":"2024-03-01","unset":null}` {
+		t.Fatalf("unexpected encoding %s (%v)", data, err)
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	expected := time.Date(2024, time.March, 1, 9, 24, 17, 0, time.UTC)
+	for _, input := range []string{
+		`"2024-03-01T10:24:17+01:00"`,
+		`"2024-03-01T10:24:17+0100"`,
+		`"2024-03-01T09:24:17Z"`,
+		`"2024-03-01T09:24:17.000Z"`,
+		`"2024-03-01T09:24:17"`,
+		`"2024-03-01 09:24:17"`,
+	} {
+		var dateTime DateTime
+		if err := json.Unmarshal([]byte(input), &dateTime); err != nil {
+			t.Fatalf("%s: %s", input, err)
+		}
+		if !dateTime.Equal(expected) {
+			t.Fatalf("%s: got %s, expected %s", input, dateTime, expected)
+		}
+	}
+
+	var dateTime DateTime
+	if err := json.Unmarshal([]byte(`"2024-03-01"`), &dateTime); err != nil || !dateTime.Equal(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected date %s (%v)", dateTime, err)
+	}
+	if err := json.Unmarshal([]byte(`null`), &dateTime); err != nil || !dateTime.IsZero() {
+		t.Fatalf("unexpected date %s (%v)", dateTime, err)
+	}
+	if err := json.Unmarshal([]byte(`"yesterday"`), &dateTime); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	data, err := json.Marshal([]DateTime{{expected}, {}})
+	if err != nil || string(data) != `["2024-03-01T09:24:17Z",null]` {
+		t.Fatalf("unexpected encoding %s (%v)", data, err)
+	}
+}
@@ -0,0 +1,129 @@
+package govh
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// UnknownFieldError is returned in strict decoding mode when a response holds
+// a field which is missing from the result type, e.g. after OVH added or
+// renamed a field.
+type UnknownFieldError struct {
+	// Name of the unknown field.
+	Field string
+	// Type the response was decoded into.
+	Type reflect.Type
+}
+
+func (err *UnknownFieldError) Error() string {
+	return fmt.Sprintf("Unknown field %q decoding %s", err.Field, err.Type)
+}
+
+// DecodeError is returned when a response can't be decoded into the result
+// type, e.g. an array where an object is expected, or a number overflowing
+// an integer field.
+type DecodeError struct {
+	// Type the response was decoded into.
+	Type reflect.Type
+	// Error of the decoder, usually a *json.SyntaxError or a
+	// *json.UnmarshalTypeError.
+	Err error
+}
+
+func (err *DecodeError) Error() string {
+	return fmt.Sprintf("Invalid response decoding %s: %s", err.Type, err.Err)
+}
+
+func (err *DecodeError) Unwrap() error {
+	return err.Err
+}
+
+// DecodeHook decodes the body of a response into result, the value given to
+// the call, for endpoints the JSON decoding doesn't handle. It returns false
+// to let the caller decode the body as JSON.
+type DecodeHook func(body []byte, result interface{}) (bool, error)
+
+// PlainTextDecodeHook decodes responses which are not JSON, such as a bare
+// word or number, into a *string, or a pointer to a number or a bool.
+func PlainTextDecodeHook(body []byte, result interface{}) (bool, error) {
+	text := strings.TrimSpace(string(body))
+	if text == "" || json.Valid(body) {
+		return false, nil
+	}
+
+	var err error
+	switch result := result.(type) {
+	case *string:
+		*result = text
+	case *int:
+		*result, err = strconv.Atoi(text)
+	case *int64:
+		*result, err = strconv.ParseInt(text, 10, 64)
+	case *float64:
+		*result, err = strconv.ParseFloat(text, 64)
+	case *bool:
+		*result, err = strconv.ParseBool(text)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+// unknownFieldPrefix starts the errors of encoding/json about unknown fields.
+const unknownFieldPrefix = "json: unknown field "
+
+// decode decodes the body of a response into result, which must be a
+// non-nil pointer. Empty bodies leave result untouched. In strict decoding
+// mode, fields missing from result are reported with an UnknownFieldError.
+func (caller *Caller) decode(body []byte, result interface{}) error {
+	t := reflect.TypeOf(result)
+	if p, ok := result.(*interface{}); ok && *p != nil {
+		t = reflect.TypeOf(*p)
+	}
+
+	if caller.DecodeHook != nil {
+		if ok, err := caller.DecodeHook(body, result); ok {
+			return err
+		}
+	}
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	if v := reflect.ValueOf(result); v.Kind() != reflect.Ptr || v.IsNil() {
+		return &json.InvalidUnmarshalError{Type: t}
+	}
+
+	if !caller.StrictDecoding {
+		if err := json.Unmarshal(body, result); err != nil {
+			return &DecodeError{Type: t, Err: err}
+		}
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(result)
+	if err != nil && strings.HasPrefix(err.Error(), unknownFieldPrefix) {
+		field, unquoteErr := strconv.Unquote(strings.TrimPrefix(err.Error(), unknownFieldPrefix))
+		if unquoteErr == nil {
+			return &UnknownFieldError{Field: field, Type: t}
+		}
+	}
+	if err == nil {
+		// Reject trailing data, as json.Unmarshal does
+		if _, tokenErr := decoder.Token(); tokenErr != io.EOF {
+			err = errors.New("invalid character after top-level value")
+		}
+	}
+	if err != nil {
+		return &DecodeError{Type: t, Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package govh
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestStrictDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"nichandle":"xx1234-ovh","currency":{"code":"EUR"}}`))
+	}))
+	defer server.Close()
+
+	var me struct {
+		Nichandle string `json:"nichandle"`
+	}
+
+	c := &Caller{URL: server.URL}
+	if err := c.Get("/me", &me); err != nil || me.Nichandle != "xx1234-ovh" {
+		t.Fatalf("unexpected result %+v (%v)", me, err)
+	}
+
+	c.StrictDecoding = true
+	err := c.Get("/me", &me)
+	var unknownField *UnknownFieldError
+	if !errors.As(err, &unknownField) || unknownField.Field != "currency" || unknownField.Type.Elem().Kind() != reflect.Struct {
+		t.Fatalf("expected an unknown field error, got %v", err)
+	}
+
+	var untyped map[string]interface{}
+	if err := c.Get("/me", &untyped); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	type zone struct {
+		Name string `json:"name"`
+		TTL  int32  `json:"ttl"`
+	}
+
+	for _, strict := range []bool{false, true} {
+		c := &Caller{StrictDecoding: strict}
+
+		for _, body := range []string{"", "  \n", `{"name":"example.com"}`} {
+			var z zone
+			if err := c.decode([]byte(body), &z); err != nil {
+				t.Fatalf("strict=%v, %q: %s", strict, body, err)
+			}
+		}
+
+		for _, body := range []string{
+			`[{"name":"example.com"}]`,
+			`{"ttl":99999999999999999999}`,
+			`{"name":"example.com"`,
+			`{"name":"example.com"} {}`,
+			`"example.com"`,
+		} {
+			var z zone
+			err := c.decode([]byte(body), &z)
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) || decodeErr.Type != reflect.TypeOf(&z) {
+				t.Fatalf("strict=%v, %q: expected a decode error, got %v", strict, body, err)
+			}
+		}
+
+		var z zone
+		if err := c.decode([]byte(`{}`), z); err == nil {
+			t.Fatalf("strict=%v: expected an error for a non-pointer result", strict)
+		}
+		if err := c.decode([]byte(`{}`), (*zone)(nil)); err == nil {
+			t.Fatalf("strict=%v: expected an error for a nil result", strict)
+		}
+	}
+}
+
+func TestDecodeHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/status":
+			w.Write([]byte("running\n"))
+		case "/count":
+			w.Write([]byte("42"))
+		default:
+			w.Write([]byte(`{"name":"example.com"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+	var status string
+	if err := c.Get("/status", &status); err == nil {
+		t.Fatal("expected an error decoding a bare word without hook")
+	}
+
+	c.DecodeHook = PlainTextDecodeHook
+	if err := c.Get("/status", &status); err != nil || status != "running" {
+		t.Fatalf("unexpected result %q (%v)", status, err)
+	}
+	var count int
+	if err := c.Get("/count", &count); err != nil || count != 42 {
+		t.Fatalf("unexpected result %d (%v)", count, err)
+	}
+	var zone struct{ Name string }
+	if err := c.Get("/zone", &zone); err != nil || zone.Name != "example.com" {
+		t.Fatalf("unexpected result %+v (%v)", zone, err)
+	}
+	if err := c.Get("/status", &count); err == nil {
+		t.Fatal("expected an error decoding a bare word into an int")
+	}
+}
+
+func FuzzDecode(f *testing.F) {
+	for _, seed := range []string{
+		``, `null`, `{}`, `[]`, `"x"`, `42`, `1e400`, `99999999999999999999`,
+		`{"name":"example.com","ttl":3600,"records":[1,2]}`, `[{"name":1}]`, `{"name":`, "\xff",
+	} {
+		f.Add([]byte(seed), false)
+		f.Add([]byte(seed), true)
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, strict bool) {
+		c := &Caller{StrictDecoding: strict, DecodeHook: PlainTextDecodeHook}
+
+		var typed struct {
+			Name    string  `json:"name"`
+			TTL     int32   `json:"ttl"`
+			Records []int64 `json:"records"`
+		}
+		var untyped interface{}
+		var list []map[string]interface{}
+		var text string
+		for _, result := range []interface{}{&typed, &untyped, &list, &text} {
+			err := c.decode(body, result)
+			var decodeErr *DecodeError
+			var unknownField *UnknownFieldError
+			if err != nil && !errors.As(err, &decodeErr) && !errors.As(err, &unknownField) {
+				if _, ok := err.(*strconv.NumError); !ok {
+					t.Fatalf("unexpected error type %T: %v", err, err)
+				}
+			}
+		}
+	})
+}
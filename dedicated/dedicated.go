@@ -0,0 +1,15 @@
+// Package dedicated wraps the /dedicated/server routes of the OVH API, which
+// manage bare-metal servers: their inventory, power, IPMI and installation.
+package dedicated
+
+import govh "github.com/garbage-collector/ovh-go"
+
+// Client calls the /dedicated/server routes.
+type Client struct {
+	api govh.Client
+}
+
+// New returns a Client calling the API with client, usually a *govh.Caller.
+func New(client govh.Client) *Client {
+	return &Client{api: client}
+}
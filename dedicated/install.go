@@ -0,0 +1,101 @@
+package dedicated
+
+import (
+	"context"
+	"net/url"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// CompatibleTemplates are the installation templates a server can be
+// installed with.
+type CompatibleTemplates struct {
+	// Templates of OVH, such as debian12_64.
+	OVH []string `json:"ovh"`
+	// Templates of the account, see me.Client.InstallationTemplates.
+	Personal []string `json:"personal"`
+}
+
+// Installation describes an OS installation of a server.
+type Installation struct {
+	TemplateName string `json:"templateName"`
+	// Partition scheme of the template, the one with the highest priority
+	// if empty.
+	PartitionSchemeName string               `json:"partitionSchemeName,omitempty"`
+	Details             *InstallationDetails `json:"details,omitempty"`
+}
+
+// InstallationDetails override the settings of the template of an
+// installation. Empty fields keep the settings of the template.
+type InstallationDetails struct {
+	CustomHostname             string `json:"customHostname,omitempty"`
+	Language                   string `json:"language,omitempty"`
+	PostInstallationScriptLink string `json:"postInstallationScriptLink,omitempty"`
+	// Name of an SSH key of the account to install.
+	SSHKeyName       string `json:"sshKeyName,omitempty"`
+	UseDistribKernel bool   `json:"useDistribKernel,omitempty"`
+	// Whether to install without software RAID, or the number of disks
+	// to use for it.
+	NoRAID          bool `json:"noRaid,omitempty"`
+	SoftRAIDDevices int  `json:"softraidDevices,omitempty"`
+	// Disk group to install on, for servers with several kinds of disks.
+	DiskGroupID int `json:"diskGroupId,omitempty"`
+}
+
+// InstallationStatus is the progress of the running installation of a
+// server.
+type InstallationStatus struct {
+	// Elapsed time since the start of the installation, in seconds.
+	ElapsedTime int64               `json:"elapsedTime"`
+	Progress    []*InstallationStep `json:"progress"`
+}
+
+// InstallationStep is a step of an installation.
+type InstallationStep struct {
+	Comment string `json:"comment"`
+	// Status of the step: todo, doing, done or error.
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+// CompatibleTemplates returns the installation templates a server can be
+// installed with.
+func (client *Client) CompatibleTemplates(ctx context.Context, serviceName string) (*CompatibleTemplates, error) {
+	templates := &CompatibleTemplates{}
+	if err := client.api.GetWithContext(ctx, serverPath(serviceName)+"/install/compatibleTemplates", templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// CompatiblePartitionSchemes returns the partition schemes of an
+// installation template a server can be installed with.
+func (client *Client) CompatiblePartitionSchemes(ctx context.Context, serviceName, templateName string) ([]string, error) {
+	var names []string
+	query := url.Values{"templateName": {templateName}}
+	if err := client.api.GetWithContext(ctx, serverPath(serviceName)+"/install/compatibleTemplatePartitionSchemes", &names, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// Install starts the installation of a server, erasing its disks. Its
+// progress is returned by InstallStatus, and the returned task is done once
+// the server is installed.
+func (client *Client) Install(ctx context.Context, serviceName string, installation *Installation) (*Task, error) {
+	task := &Task{}
+	if err := client.api.PostWithContext(ctx, serverPath(serviceName)+"/install/start", installation, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// InstallStatus returns the progress of the running installation of a
+// server. A not found error is returned when no installation is running.
+func (client *Client) InstallStatus(ctx context.Context, serviceName string) (*InstallationStatus, error) {
+	status := &InstallationStatus{}
+	if err := client.api.GetWithContext(ctx, serverPath(serviceName)+"/install/status", status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
@@ -0,0 +1,74 @@
+package dedicated
+
+import (
+	"context"
+	"testing"
+
+	govh "github.com/garbage-collector/ovh-go"
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestInstall(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /dedicated/server/ns1/install/compatibleTemplates", 200, map[string]interface{}{"ovh": []string{"debian12_64", "ubuntu2404-server_64"}, "personal": []string{"debian-web"}})
+	server.Handle("GET /dedicated/server/ns1/install/compatibleTemplatePartitionSchemes", 200, []string{"default", "raid"})
+	server.Handle("POST /dedicated/server/ns1/install/start", 200, map[string]interface{}{"taskId": 51, "function": "reinstallServer", "status": "init"})
+	server.Handle("GET /dedicated/server/ns1/install/status", 200, map[string]interface{}{
+		"elapsedTime": 320,
+		"progress": []map[string]interface{}{
+			{"comment": "Preparing installation", "status": "done", "error": ""},
+			{"comment": "Installing the OS", "status": "doing", "error": ""},
+		},
+	})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	templates, err := client.CompatibleTemplates(ctx, "ns1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(templates.OVH) != 2 || len(templates.Personal) != 1 {
+		t.Fatalf("unexpected templates %+v", templates)
+	}
+
+	schemes, err := client.CompatiblePartitionSchemes(ctx, "ns1", "debian-web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schemes) != 2 || server.LastRequest().Query.Get("templateName") != "debian-web" {
+		t.Fatalf("unexpected partition schemes %v", schemes)
+	}
+
+	task, err := client.Install(ctx, "ns1", &Installation{
+		TemplateName:        "debian-web",
+		PartitionSchemeName: "raid",
+		Details:             &InstallationDetails{CustomHostname: "web1.example.com", SSHKeyName: "deploy"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); task.TaskID != 51 || body != `{"templateName":"debian-web","partitionSchemeName":"raid","details":{"customHostname":"web1.example.com","sshKeyName":"deploy"}}` {
+		t.Fatalf("unexpected task %+v for %s", task, body)
+	}
+
+	status, err := client.InstallStatus(ctx, "ns1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.ElapsedTime != 320 || len(status.Progress) != 2 || status.Progress[1].Status != "doing" {
+		t.Fatalf("unexpected status %+v", status)
+	}
+}
+
+func TestInstallStatusNotRunning(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.HandleError("GET /dedicated/server/ns1/install/status", 404, "Client::NotFound", "Server is not being installed or reinstalled at the moment")
+
+	client := New(server.Caller())
+	if _, err := client.InstallStatus(context.Background(), "ns1"); !govh.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
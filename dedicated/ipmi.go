@@ -0,0 +1,99 @@
+package dedicated
+
+import (
+	"context"
+	"net/url"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// IPMI access types.
+const (
+	// IPMIKVMHTML5 is a URL to the KVM of the server, in a browser.
+	IPMIKVMHTML5 = "kvmipHtml5URL"
+	// IPMIKVMJNLP is a Java Web Start file opening the KVM of the server.
+	IPMIKVMJNLP = "kvmipJnlp"
+	// IPMISerialOverLANURL is a URL to the serial console of the server, in
+	// a browser.
+	IPMISerialOverLANURL = "serialOverLanURL"
+	// IPMISerialOverLANSSHKey is an SSH command opening the serial console
+	// of the server, authenticated with the given key.
+	IPMISerialOverLANSSHKey = "serialOverLanSshKey"
+)
+
+// IPMI tells whether the IPMI of a server is available, and which accesses
+// it supports.
+type IPMI struct {
+	Activated         bool                `json:"activated"`
+	SupportedFeatures IPMISupportedAccess `json:"supportedFeatures"`
+}
+
+// IPMISupportedAccess lists the IPMI accesses supported by a server.
+type IPMISupportedAccess struct {
+	KVMHTML5            bool `json:"kvmipHtml5URL"`
+	KVMJNLP             bool `json:"kvmipJnlp"`
+	SerialOverLANURL    bool `json:"serialOverLanURL"`
+	SerialOverLANSSHKey bool `json:"serialOverLanSshKey"`
+}
+
+// IPMIAccessRequest describes an IPMI access to open.
+type IPMIAccessRequest struct {
+	// Type is one of the IPMI constants.
+	Type string `json:"type"`
+	// TTL of the access in minutes: 1, 3, 5, 10 or 15.
+	TTL int `json:"ttl"`
+	// IP address allowed to use the access, required for browser accesses.
+	IPToAllow string `json:"ipToAllow,omitempty"`
+	// Public SSH key, required for IPMISerialOverLANSSHKey.
+	SSHKey string `json:"sshKey,omitempty"`
+}
+
+// IPMIAccess is an opened IPMI access: a URL, a file or a command depending
+// on its type.
+type IPMIAccess struct {
+	Value      string        `json:"value"`
+	Expiration govh.DateTime `json:"expiration"`
+}
+
+// IPMI returns the IPMI features of a server.
+func (client *Client) IPMI(ctx context.Context, serviceName string) (*IPMI, error) {
+	result := &IPMI{}
+	if err := client.api.GetWithContext(ctx, serverPath(serviceName)+"/features/ipmi", result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RequestIPMIAccess opens an IPMI access to a server. The access can be
+// fetched with IPMIAccess once the returned task is done.
+func (client *Client) RequestIPMIAccess(ctx context.Context, serviceName string, request *IPMIAccessRequest) (*Task, error) {
+	task := &Task{}
+	if err := client.api.PostWithContext(ctx, serverPath(serviceName)+"/features/ipmi/access", request, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// IPMIAccess returns the last IPMI access of the given type opened to a
+// server.
+func (client *Client) IPMIAccess(ctx context.Context, serviceName, accessType string) (*IPMIAccess, error) {
+	access := &IPMIAccess{}
+	query := url.Values{"type": {accessType}}
+	if err := client.api.GetWithContext(ctx, serverPath(serviceName)+"/features/ipmi/access", access, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return access, nil
+}
+
+// OpenIPMIAccess opens an IPMI access to a server, waits for it to be ready
+// and returns it. A nil options uses the defaults of govh.WaitForTask.
+func (client *Client) OpenIPMIAccess(ctx context.Context, serviceName string, request *IPMIAccessRequest, options *govh.TaskOptions) (*IPMIAccess, error) {
+	task, err := client.RequestIPMIAccess(ctx, serviceName, request)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.WaitForTask(ctx, serviceName, task.TaskID, options); err != nil {
+		return nil, err
+	}
+	return client.IPMIAccess(ctx, serviceName, request.Type)
+}
@@ -0,0 +1,44 @@
+package dedicated
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestIPMI(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /dedicated/server/ns1/features/ipmi", 200, map[string]interface{}{"activated": true, "supportedFeatures": map[string]interface{}{"kvmipHtml5URL": true, "serialOverLanURL": true}})
+	server.Handle("POST /dedicated/server/ns1/features/ipmi/access", 200, map[string]interface{}{"taskId": 7, "function": "ipmiAccess", "status": "todo"})
+	server.Handle("GET /dedicated/server/ns1/task/7", 200, map[string]interface{}{"taskId": 7, "function": "ipmiAccess", "status": "done"})
+	server.Handle("GET /dedicated/server/ns1/features/ipmi/access", 200, map[string]interface{}{"value": "https://ipmi.example.net/kvm?token=abc", "expiration": "2024-03-01T10:15:00+01:00"})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	ipmi, err := client.IPMI(ctx, "ns1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ipmi.Activated || !ipmi.SupportedFeatures.KVMHTML5 || ipmi.SupportedFeatures.KVMJNLP {
+		t.Fatalf("unexpected IPMI %+v", ipmi)
+	}
+
+	access, err := client.OpenIPMIAccess(ctx, "ns1", &IPMIAccessRequest{Type: IPMIKVMHTML5, TTL: 15, IPToAllow: "198.51.100.4"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if access.Value != "https://ipmi.example.net/kvm?token=abc" || access.Expiration.Minute() != 15 {
+		t.Fatalf("unexpected access %+v", access)
+	}
+
+	requests := server.Requests()
+	if body := string(requests[1].Body); body != `{"type":"kvmipHtml5URL","ttl":15,"ipToAllow":"198.51.100.4"}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+	if query := server.LastRequest().Query.Get("type"); query != IPMIKVMHTML5 {
+		t.Fatalf("unexpected access type %q", query)
+	}
+}
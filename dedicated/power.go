@@ -0,0 +1,69 @@
+package dedicated
+
+import (
+	"context"
+	"fmt"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Boot types.
+const (
+	BootHarddisk = "harddisk"
+	BootRescue   = "rescue"
+	BootInternal = "internal"
+	BootIPXE     = "ipxeCustomerScript"
+)
+
+// Boot is a netboot a server may start from.
+type Boot struct {
+	BootID int64 `json:"bootId"`
+	// BootType is one of the Boot constants.
+	BootType    string `json:"bootType"`
+	Description string `json:"description"`
+	Kernel      string `json:"kernel"`
+}
+
+// BootFilter filters the netboots returned by Boots.
+type BootFilter struct {
+	BootType string `url:"bootType,omitempty"`
+}
+
+// Reboot hard reboots a server.
+func (client *Client) Reboot(ctx context.Context, serviceName string) (*Task, error) {
+	task := &Task{}
+	if err := client.api.PostWithContext(ctx, serverPath(serviceName)+"/reboot", nil, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// Boots returns the identifiers of the netboots compatible with a server. A
+// nil filter returns all of them.
+func (client *Client) Boots(ctx context.Context, serviceName string, filter *BootFilter) ([]int64, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, serverPath(serviceName)+"/boot", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Boot returns a netboot of a server.
+func (client *Client) Boot(ctx context.Context, serviceName string, bootID int64) (*Boot, error) {
+	boot := &Boot{}
+	if err := client.api.GetWithContext(ctx, fmt.Sprintf("%s/boot/%d", serverPath(serviceName), bootID), boot); err != nil {
+		return nil, err
+	}
+	return boot, nil
+}
+
+// SetBoot selects the netboot a server starts from on its next reboot, such
+// as a rescue system.
+func (client *Client) SetBoot(ctx context.Context, serviceName string, bootID int64) error {
+	return client.api.PutWithContext(ctx, serverPath(serviceName), map[string]interface{}{"bootId": bootID}, nil)
+}
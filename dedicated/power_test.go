@@ -0,0 +1,68 @@
+package dedicated
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestReboot(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("POST /dedicated/server/ns1/reboot", 200, map[string]interface{}{"taskId": 42, "function": "hardReboot", "status": "init"})
+	server.Handle("GET /dedicated/server/ns1/task/42", 200, map[string]interface{}{"taskId": 42, "function": "hardReboot", "status": "done", "doneDate": "2024-03-01T10:00:00+01:00"})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	task, err := client.Reboot(ctx, "ns1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.TaskID != 42 || task.Function != "hardReboot" {
+		t.Fatalf("unexpected task %+v", task)
+	}
+
+	task, err = client.WaitForTask(ctx, "ns1", task.TaskID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Status != "done" || task.DoneDate.IsZero() {
+		t.Fatalf("unexpected task %+v", task)
+	}
+}
+
+func TestBoots(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /dedicated/server/ns1/boot", 200, []int64{22})
+	server.Handle("GET /dedicated/server/ns1/boot/22", 200, map[string]interface{}{"bootId": 22, "bootType": "rescue", "description": "rescue-customer", "kernel": "rescue64-pro"})
+	server.Handle("PUT /dedicated/server/ns1", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	ids, err := client.Boots(ctx, "ns1", &BootFilter{BootType: BootRescue})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 || server.LastRequest().Query.Get("bootType") != "rescue" {
+		t.Fatalf("unexpected boots %v", ids)
+	}
+
+	boot, err := client.Boot(ctx, "ns1", ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if boot.BootType != BootRescue || boot.Kernel != "rescue64-pro" {
+		t.Fatalf("unexpected boot %+v", boot)
+	}
+
+	if err := client.SetBoot(ctx, "ns1", boot.BootID); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"bootId":22}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+}
@@ -0,0 +1,82 @@
+package dedicated
+
+import (
+	"context"
+	"net/url"
+
+	govh "github.com/garbage-collector/ovh-go"
+	"github.com/garbage-collector/ovh-go/services"
+)
+
+// Server states.
+const (
+	StateOK            = "ok"
+	StateError         = "error"
+	StateHacked        = "hacked"
+	StateHackedBlocked = "hackedBlocked"
+)
+
+// Power states.
+const (
+	PowerOn  = "poweron"
+	PowerOff = "poweroff"
+)
+
+// ServerInfo is a dedicated server.
+type ServerInfo struct {
+	// Name is the service name of the server, such as
+	// ns1234567.ip-192-0-2.eu.
+	Name            string `json:"name"`
+	ServerID        int64  `json:"serverId"`
+	CommercialRange string `json:"commercialRange"`
+	Datacenter      string `json:"datacenter"`
+	Rack            string `json:"rack"`
+	IP              string `json:"ip"`
+	Reverse         string `json:"reverse"`
+	// Speed of the public network link in Mbps.
+	LinkSpeed int `json:"linkSpeed"`
+	// OS installed on the server, such as debian12_64.
+	OS string `json:"os"`
+	// BootID is the netboot used on the next reboot, see Boots.
+	BootID     int64             `json:"bootId"`
+	RootDevice govh.Null[string] `json:"rootDevice"`
+	RescueMail govh.Null[string] `json:"rescueMail"`
+	// State is one of the State constants.
+	State      string `json:"state"`
+	PowerState string `json:"powerState"`
+	// Whether OVH monitors the server, and intervenes when it doesn't
+	// answer pings anymore, unless NoIntervention is set.
+	Monitoring       bool   `json:"monitoring"`
+	NoIntervention   bool   `json:"noIntervention"`
+	ProfessionalUse  bool   `json:"professionalUse"`
+	SupportLevel     string `json:"supportLevel"`
+	NewUpgradeSystem bool   `json:"newUpgradeSystem"`
+}
+
+// List returns the service names of the dedicated servers of the account.
+func (client *Client) List(ctx context.Context) ([]string, error) {
+	var names []string
+	if err := client.api.GetWithContext(ctx, "/dedicated/server", &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// Get returns the details of a dedicated server.
+func (client *Client) Get(ctx context.Context, serviceName string) (*ServerInfo, error) {
+	result := &ServerInfo{}
+	if err := client.api.GetWithContext(ctx, serverPath(serviceName), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ServiceInfos returns the subscription of a dedicated server, such as its
+// expiration date and renewal.
+func (client *Client) ServiceInfos(ctx context.Context, serviceName string) (*services.ServiceInfos, error) {
+	return services.New(client.api).ServiceInfos(ctx, serverPath(serviceName))
+}
+
+func serverPath(serviceName string) string {
+	return "/dedicated/server/" + url.PathEscape(serviceName)
+}
@@ -0,0 +1,52 @@
+package dedicated
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestServers(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.HandleFixtures()
+	server.Handle("GET /dedicated/server/{serviceName}/serviceInfos", 200, map[string]interface{}{"domain": "ns1234567.ip-192-0-2.eu", "expiration": "2025-06-01", "renew": map[string]interface{}{"automatic": true, "period": 1}})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	names, err := client.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 || names[0] != "ns1234567.ip-192-0-2.eu" {
+		t.Fatalf("unexpected servers %v", names)
+	}
+
+	info, err := client.Get(ctx, names[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Datacenter != "rbx8" || info.Rack != "R801B01" || info.CommercialRange != "advance-1" || info.State != StateOK || !info.Monitoring {
+		t.Fatalf("unexpected server %+v", info)
+	}
+	if info.PowerState != PowerOn || info.RootDevice.Valid || info.BootID != 1 {
+		t.Fatalf("unexpected server %+v", info)
+	}
+
+	infos, err := client.ServiceInfos(ctx, names[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if infos.Expiration.String() != "2025-06-01" || infos.Renew.Period != 1 {
+		t.Fatalf("unexpected service infos %+v", infos)
+	}
+	if path := server.LastRequest().Path; path != "/dedicated/server/ns1234567.ip-192-0-2.eu/serviceInfos" {
+		t.Fatalf("unexpected path %s", path)
+	}
+}
+
+func TestServerFixture(t *testing.T) {
+	govhtest.DecodeFixture(t, "dedicated_server", &ServerInfo{})
+}
@@ -0,0 +1,28 @@
+package dedicated
+
+import (
+	"context"
+	"fmt"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Task is an operation on a server run asynchronously by the API, such as a
+// reboot.
+type Task struct {
+	TaskID   int64  `json:"taskId"`
+	Function string `json:"function"`
+	// Status of the task, such as todo, doing, done or customerError.
+	Status     string        `json:"status"`
+	Comment    string        `json:"comment"`
+	StartDate  govh.DateTime `json:"startDate"`
+	DoneDate   govh.DateTime `json:"doneDate"`
+	LastUpdate govh.DateTime `json:"lastUpdate"`
+}
+
+// WaitForTask waits for a task of a server to end. A *govh.TaskError is
+// returned if it failed. A nil options uses the defaults of
+// govh.WaitForTask.
+func (client *Client) WaitForTask(ctx context.Context, serviceName string, taskID int64, options *govh.TaskOptions) (*Task, error) {
+	return govh.WaitForTaskPath[*Task](ctx, client.api, fmt.Sprintf("%s/task/%d", serverPath(serviceName), taskID), options)
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// DNSSEC statuses of a zone. Enabling and disabling take a while, during
+// which the status is one of the InProgress ones.
+const (
+	DNSSECEnabled           = "enabled"
+	DNSSECDisabled          = "disabled"
+	DNSSECEnableInProgress  = "enableInProgress"
+	DNSSECDisableInProgress = "disableInProgress"
+)
+
+// DNSSEC key flags.
+const (
+	DSFlagZoneSigningKey = 256
+	DSFlagKeySigningKey  = 257
+)
+
+// DSRecord is a DS record published by the registry of a domain, delegating
+// trust to a key signing the zone.
+type DSRecord struct {
+	ID        int64  `json:"id"`
+	Algorithm int    `json:"algorithm"`
+	Flags     int    `json:"flags"`
+	PublicKey string `json:"publicKey"`
+	Tag       int    `json:"tag"`
+	// Status of the record at the registry, such as created or
+	// toCreate.
+	Status string `json:"status"`
+}
+
+// DSKey is a key to publish as a DS record of a domain.
+type DSKey struct {
+	// Algorithm number, such as 8 for RSASHA256 or 13 for
+	// ECDSAP256SHA256.
+	Algorithm int    `json:"algorithm"`
+	Flags     int    `json:"flags"`
+	PublicKey string `json:"publicKey"`
+	Tag       int    `json:"tag"`
+}
+
+// DNSSECStatus returns the DNSSEC status of a zone, one of the DNSSEC
+// constants.
+func (client *Client) DNSSECStatus(ctx context.Context, zone string) (string, error) {
+	var result struct {
+		Status string `json:"status"`
+	}
+	if err := client.api.GetWithContext(ctx, zonePath(zone)+"/dnssec", &result); err != nil {
+		return "", err
+	}
+	return result.Status, nil
+}
+
+// EnableDNSSEC signs a zone. When the zone is served by OVH for a domain
+// registered with OVH, the DS records are published by the registry
+// without further action.
+func (client *Client) EnableDNSSEC(ctx context.Context, zone string) error {
+	return client.api.PostWithContext(ctx, zonePath(zone)+"/dnssec", nil, nil)
+}
+
+// DisableDNSSEC stops signing a zone.
+func (client *Client) DisableDNSSEC(ctx context.Context, zone string) error {
+	return client.api.DeleteWithContext(ctx, zonePath(zone)+"/dnssec", nil)
+}
+
+// DSRecordFilter filters the DS records returned by DSRecords.
+type DSRecordFilter struct {
+	Flags  int    `url:"flags,omitempty"`
+	Status string `url:"status,omitempty"`
+}
+
+// DSRecords returns the identifiers of the DS records of a domain. A nil
+// filter returns all of them.
+func (client *Client) DSRecords(ctx context.Context, domain string, filter *DSRecordFilter) ([]int64, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, domainPath(domain)+"/dsRecord", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// DSRecord returns a DS record of a domain.
+func (client *Client) DSRecord(ctx context.Context, domain string, id int64) (*DSRecord, error) {
+	result := &DSRecord{}
+	if err := client.api.GetWithContext(ctx, fmt.Sprintf("%s/dsRecord/%d", domainPath(domain), id), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SetDSRecords replaces the DS records of a domain, for zones not served by
+// OVH. An empty keys removes them all, disabling DNSSEC at the registry.
+func (client *Client) SetDSRecords(ctx context.Context, domain string, keys []*DSKey) (*DomainTask, error) {
+	if keys == nil {
+		keys = []*DSKey{}
+	}
+	task := &DomainTask{}
+	if err := client.api.PostWithContext(ctx, domainPath(domain)+"/dsRecord", map[string]interface{}{"keys": keys}, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestDNSSEC(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain/zone/example.com/dnssec", 200, map[string]interface{}{"status": "enableInProgress"})
+	server.Handle("POST /domain/zone/example.com/dnssec", 200, nil)
+	server.Handle("DELETE /domain/zone/example.com/dnssec", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	status, err := client.DNSSECStatus(ctx, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != DNSSECEnableInProgress {
+		t.Fatalf("unexpected status %q", status)
+	}
+
+	if err := client.EnableDNSSEC(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if request := server.LastRequest(); request.Method != "POST" {
+		t.Fatalf("unexpected request %s %s", request.Method, request.Path)
+	}
+	if err := client.DisableDNSSEC(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if request := server.LastRequest(); request.Method != "DELETE" {
+		t.Fatalf("unexpected request %s %s", request.Method, request.Path)
+	}
+}
+
+func TestDSRecords(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain/example.com/dsRecord", 200, []int64{12})
+	server.Handle("GET /domain/example.com/dsRecord/12", 200, map[string]interface{}{"id": 12, "algorithm": 13, "flags": 257, "publicKey": "mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ==", "tag": 2371, "status": "created"})
+	server.Handle("POST /domain/example.com/dsRecord", 200, map[string]interface{}{"id": 99, "function": "DomainDnsUpdate", "status": "todo"})
+	server.Handle("GET /domain/example.com/task/99", 200, map[string]interface{}{"id": 99, "function": "DomainDnsUpdate", "status": "done"})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	ids, err := client.DSRecords(ctx, "example.com", &DSRecordFilter{Flags: DSFlagKeySigningKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 || server.LastRequest().Query.Get("flags") != "257" {
+		t.Fatalf("unexpected records %v for %v", ids, server.LastRequest().Query)
+	}
+
+	record, err := client.DSRecord(ctx, "example.com", ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Algorithm != 13 || record.Flags != DSFlagKeySigningKey || record.Tag != 2371 || record.Status != "created" {
+		t.Fatalf("unexpected record %+v", record)
+	}
+
+	task, err := client.SetDSRecords(ctx, "example.com", []*DSKey{{Algorithm: 13, Flags: 257, PublicKey: "key", Tag: 2371}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"keys":[{"algorithm":13,"flags":257,"publicKey":"key","tag":2371}]}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+	if task, err = client.WaitForDomainTask(ctx, "example.com", task.ID, nil); err != nil || task.Status != "done" {
+		t.Fatalf("unexpected task %+v (%v)", task, err)
+	}
+
+	if _, err := client.SetDSRecords(ctx, "example.com", nil); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"keys":[]}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+}
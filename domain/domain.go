@@ -0,0 +1,124 @@
+// Package domain wraps the /domain routes of the OVH API, which manage domain
+// names and their DNS zones.
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	govh "github.com/garbage-collector/ovh-go"
+	"github.com/garbage-collector/ovh-go/services"
+)
+
+// Client calls the /domain routes.
+type Client struct {
+	api govh.Client
+}
+
+// New returns a Client calling the API with client, usually a *govh.Caller.
+func New(client govh.Client) *Client {
+	return &Client{api: client}
+}
+
+// Name server types.
+const (
+	NameServerHosted   = "hosted"
+	NameServerExternal = "external"
+)
+
+// Transfer lock statuses. Locking and unlocking are transient.
+const (
+	TransferLocked    = "locked"
+	TransferLocking   = "locking"
+	TransferUnlocked  = "unlocked"
+	TransferUnlocking = "unlocking"
+)
+
+// Domain is a domain name registered with OVH.
+type Domain struct {
+	Domain string `json:"domain"`
+	Offer  string `json:"offer"`
+	// NameServerType is NameServerHosted, for name servers provided by OVH,
+	// or NameServerExternal.
+	NameServerType     string         `json:"nameServerType"`
+	TransferLockStatus string         `json:"transferLockStatus"`
+	WhoisOwner         string         `json:"whoisOwner"`
+	ParentService      *ParentService `json:"parentService"`
+	LastUpdate         govh.DateTime  `json:"lastUpdate"`
+	// Features supported by the extension of the domain.
+	DNSSECSupported            bool `json:"dnssecSupported"`
+	GlueRecordIPv6Supported    bool `json:"glueRecordIpv6Supported"`
+	GlueRecordMultiIPSupported bool `json:"glueRecordMultiIpSupported"`
+	OwoSupported               bool `json:"owoSupported"`
+}
+
+// ParentService is the service a domain is included in, such as a web
+// hosting.
+type ParentService struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// DomainUpdate lists the properties of a domain to modify. Empty fields are
+// left unchanged.
+type DomainUpdate struct {
+	NameServerType     string `json:"nameServerType,omitempty"`
+	TransferLockStatus string `json:"transferLockStatus,omitempty"`
+}
+
+// List returns the domains of the account.
+func (client *Client) List(ctx context.Context) ([]string, error) {
+	var domains []string
+	if err := client.api.GetWithContext(ctx, "/domain", &domains); err != nil {
+		return nil, err
+	}
+	return domains, nil
+}
+
+// Get returns the details of a domain.
+func (client *Client) Get(ctx context.Context, domain string) (*Domain, error) {
+	result := &Domain{}
+	if err := client.api.GetWithContext(ctx, domainPath(domain), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Update modifies the properties of a domain.
+func (client *Client) Update(ctx context.Context, domain string, update *DomainUpdate) error {
+	return client.api.PutWithContext(ctx, domainPath(domain), update, nil)
+}
+
+// ServiceInfos returns the subscription of a domain, such as its expiration
+// date and renewal.
+func (client *Client) ServiceInfos(ctx context.Context, domain string) (*services.ServiceInfos, error) {
+	return services.New(client.api).ServiceInfos(ctx, domainPath(domain))
+}
+
+// DomainTask is an operation on a domain run asynchronously by the API, such
+// as an update of its DS records.
+type DomainTask struct {
+	ID       int64  `json:"id"`
+	Function string `json:"function"`
+	// Status of the task: cancelled, doing, done, error or todo.
+	Status       string        `json:"status"`
+	Comment      string        `json:"comment"`
+	CanCancel    bool          `json:"canCancel"`
+	CanRelaunch  bool          `json:"canRelaunch"`
+	CreationDate govh.DateTime `json:"creationDate"`
+	TodoDate     govh.DateTime `json:"todoDate"`
+	DoneDate     govh.DateTime `json:"doneDate"`
+	LastUpdate   govh.DateTime `json:"lastUpdate"`
+}
+
+// WaitForDomainTask waits for a task of a domain to end. A *govh.TaskError is
+// returned if it failed. A nil options uses the defaults of
+// govh.WaitForTask.
+func (client *Client) WaitForDomainTask(ctx context.Context, domain string, taskID int64, options *govh.TaskOptions) (*DomainTask, error) {
+	return govh.WaitForTaskPath[*DomainTask](ctx, client.api, fmt.Sprintf("%s/task/%d", domainPath(domain), taskID), options)
+}
+
+func domainPath(domain string) string {
+	return "/domain/" + url.PathEscape(domain)
+}
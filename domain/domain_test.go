@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestDomains(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.HandleFixtures()
+	server.Handle("PUT /domain/example.com", 200, nil)
+	server.Handle("GET /domain/example.com/serviceInfos", 200, map[string]interface{}{"domain": "example.com", "expiration": "2025-03-01", "renew": map[string]interface{}{"automatic": true}})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	domains, err := client.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(domains) != 1 || domains[0] != "example.com" {
+		t.Fatalf("unexpected domains %v", domains)
+	}
+
+	domain, err := client.Get(ctx, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain.NameServerType != NameServerHosted || domain.TransferLockStatus != TransferLocked || domain.ParentService != nil || domain.LastUpdate.Year() != 2024 {
+		t.Fatalf("unexpected domain %+v", domain)
+	}
+
+	if err := client.Update(ctx, "example.com", &DomainUpdate{TransferLockStatus: TransferUnlocked}); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"transferLockStatus":"unlocked"}` {
+		t.Fatalf("unexpected update %s", body)
+	}
+
+	infos, err := client.ServiceInfos(ctx, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if infos.Expiration.String() != "2025-03-01" || !infos.Renew.Automatic {
+		t.Fatalf("unexpected service infos %+v", infos)
+	}
+}
+
+func TestDomainFixture(t *testing.T) {
+	govhtest.DecodeFixture(t, "domain", &Domain{})
+}
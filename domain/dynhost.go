@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// DynHostRecord is a record of a zone whose IP address is updated by a
+// client, such as a home router, with the credentials of a DynHostLogin.
+type DynHostRecord struct {
+	ID        int64  `json:"id"`
+	Zone      string `json:"zone"`
+	SubDomain string `json:"subDomain"`
+	IP        string `json:"ip"`
+	TTL       int64  `json:"ttl"`
+}
+
+// DynHostLogin is a credential allowed to update the DynHost records of a
+// zone matching SubDomain, which may contain a * wildcard.
+type DynHostLogin struct {
+	Login     string `json:"login"`
+	Zone      string `json:"zone"`
+	SubDomain string `json:"subDomain"`
+}
+
+// DynHostRecordFilter filters the records returned by DynHostRecords.
+type DynHostRecordFilter struct {
+	SubDomain string `url:"subDomain,omitempty"`
+}
+
+// DynHostLoginFilter filters the logins returned by DynHostLogins.
+type DynHostLoginFilter struct {
+	Login     string `url:"login,omitempty"`
+	SubDomain string `url:"subDomain,omitempty"`
+}
+
+// DynHostRecords returns the identifiers of the DynHost records of a zone.
+// A nil filter returns all of them.
+func (client *Client) DynHostRecords(ctx context.Context, zone string, filter *DynHostRecordFilter) ([]int64, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, zonePath(zone)+"/dynHost/record", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// DynHostRecord returns a DynHost record of a zone.
+func (client *Client) DynHostRecord(ctx context.Context, zone string, recordID int64) (*DynHostRecord, error) {
+	record := &DynHostRecord{}
+	if err := client.api.GetWithContext(ctx, dynHostRecordPath(zone, recordID), record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// CreateDynHostRecord adds a DynHost record to a zone, and returns it with
+// its identifier.
+func (client *Client) CreateDynHostRecord(ctx context.Context, zone, subDomain, ip string) (*DynHostRecord, error) {
+	body := map[string]interface{}{"subDomain": subDomain, "ip": ip}
+
+	created := &DynHostRecord{}
+	if err := client.api.PostWithContext(ctx, zonePath(zone)+"/dynHost/record", body, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateDynHostRecord modifies the name and IP address of a DynHost record.
+func (client *Client) UpdateDynHostRecord(ctx context.Context, zone string, record *DynHostRecord) error {
+	body := map[string]interface{}{"subDomain": record.SubDomain, "ip": record.IP}
+	return client.api.PutWithContext(ctx, dynHostRecordPath(zone, record.ID), body, nil)
+}
+
+// DeleteDynHostRecord removes a DynHost record from a zone.
+func (client *Client) DeleteDynHostRecord(ctx context.Context, zone string, recordID int64) error {
+	return client.api.DeleteWithContext(ctx, dynHostRecordPath(zone, recordID), nil)
+}
+
+// UpdateDynHost points the DynHost record of subDomain to ip, creating it if
+// needed, as a dynamic DNS client would. It returns the record, and whether
+// it changed.
+func (client *Client) UpdateDynHost(ctx context.Context, zone, subDomain, ip string) (*DynHostRecord, bool, error) {
+	ids, err := client.DynHostRecords(ctx, zone, &DynHostRecordFilter{SubDomain: subDomain})
+	if err != nil {
+		return nil, false, err
+	}
+	// An empty subDomain filter matches every name: look for the record of
+	// the requested name only.
+	for _, id := range ids {
+		record, err := client.DynHostRecord(ctx, zone, id)
+		if err != nil {
+			return nil, false, err
+		}
+		if record.SubDomain != subDomain {
+			continue
+		}
+		if record.IP == ip {
+			return record, false, nil
+		}
+		record.IP = ip
+		if err := client.UpdateDynHostRecord(ctx, zone, record); err != nil {
+			return nil, false, err
+		}
+		return record, true, nil
+	}
+
+	created, err := client.CreateDynHostRecord(ctx, zone, subDomain, ip)
+	return created, err == nil, err
+}
+
+// DynHostLogins returns the DynHost logins of a zone. A nil filter returns
+// all of them.
+func (client *Client) DynHostLogins(ctx context.Context, zone string, filter *DynHostLoginFilter) ([]string, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var logins []string
+	if err := client.api.GetWithContext(ctx, zonePath(zone)+"/dynHost/login", &logins, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return logins, nil
+}
+
+// DynHostLogin returns a DynHost login of a zone.
+func (client *Client) DynHostLogin(ctx context.Context, zone, login string) (*DynHostLogin, error) {
+	result := &DynHostLogin{}
+	if err := client.api.GetWithContext(ctx, dynHostLoginPath(zone, login), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateDynHostLogin creates a login allowed to update the DynHost records
+// matching subDomain. The login is the zone followed by "-" and
+// loginSuffix.
+func (client *Client) CreateDynHostLogin(ctx context.Context, zone, loginSuffix, subDomain, password string) (*DynHostLogin, error) {
+	body := map[string]interface{}{"loginSuffix": loginSuffix, "subDomain": subDomain, "password": password}
+
+	created := &DynHostLogin{}
+	if err := client.api.PostWithContext(ctx, zonePath(zone)+"/dynHost/login", body, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateDynHostLogin modifies the records a DynHost login may update.
+func (client *Client) UpdateDynHostLogin(ctx context.Context, zone, login, subDomain string) error {
+	return client.api.PutWithContext(ctx, dynHostLoginPath(zone, login), map[string]interface{}{"subDomain": subDomain}, nil)
+}
+
+// ChangeDynHostPassword sets the password of a DynHost login.
+func (client *Client) ChangeDynHostPassword(ctx context.Context, zone, login, password string) error {
+	return client.api.PostWithContext(ctx, dynHostLoginPath(zone, login)+"/changePassword", map[string]interface{}{"password": password}, nil)
+}
+
+// DeleteDynHostLogin removes a DynHost login.
+func (client *Client) DeleteDynHostLogin(ctx context.Context, zone, login string) error {
+	return client.api.DeleteWithContext(ctx, dynHostLoginPath(zone, login), nil)
+}
+
+func dynHostRecordPath(zone string, recordID int64) string {
+	return fmt.Sprintf("%s/dynHost/record/%d", zonePath(zone), recordID)
+}
+
+func dynHostLoginPath(zone, login string) string {
+	return zonePath(zone) + "/dynHost/login/" + url.PathEscape(login)
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestUpdateDynHost(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain/zone/example.com/dynHost/record", 200, []int64{3})
+	server.Handle("GET /domain/zone/example.com/dynHost/record/3", 200, map[string]interface{}{"id": 3, "zone": "example.com", "subDomain": "home", "ip": "192.0.2.1", "ttl": 60})
+	server.Handle("PUT /domain/zone/example.com/dynHost/record/3", 200, nil)
+	server.Handle("POST /domain/zone/example.com/dynHost/record", 200, map[string]interface{}{"id": 4, "zone": "example.com", "subDomain": "office", "ip": "192.0.2.7"})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	record, changed, err := client.UpdateDynHost(ctx, "example.com", "home", "192.0.2.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed || record.ID != 3 || server.LastRequest().Method != "GET" {
+		t.Fatalf("unexpected record %+v (changed: %v)", record, changed)
+	}
+
+	record, changed, err = client.UpdateDynHost(ctx, "example.com", "home", "192.0.2.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || record.IP != "192.0.2.2" {
+		t.Fatalf("unexpected record %+v (changed: %v)", record, changed)
+	}
+	if body := string(server.LastRequest().Body); body != `{"ip":"192.0.2.2","subDomain":"home"}` {
+		t.Fatalf("unexpected update %s", body)
+	}
+
+	record, changed, err = client.UpdateDynHost(ctx, "example.com", "office", "192.0.2.7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || record.ID != 4 {
+		t.Fatalf("unexpected record %+v (changed: %v)", record, changed)
+	}
+	if request := server.LastRequest(); request.Method != "POST" || string(request.Body) != `{"ip":"192.0.2.7","subDomain":"office"}` {
+		t.Fatalf("unexpected request %s %s", request.Method, request.Body)
+	}
+}
+
+func TestDynHostLogins(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain/zone/example.com/dynHost/login", 200, []string{"example.com-home"})
+	server.Handle("GET /domain/zone/example.com/dynHost/login/example.com-home", 200, map[string]interface{}{"login": "example.com-home", "zone": "example.com", "subDomain": "*"})
+	server.Handle("POST /domain/zone/example.com/dynHost/login", 200, map[string]interface{}{"login": "example.com-router", "zone": "example.com", "subDomain": "home"})
+	server.Handle("PUT /domain/zone/example.com/dynHost/login/example.com-router", 200, nil)
+	server.Handle("POST /domain/zone/example.com/dynHost/login/example.com-router/changePassword", 200, nil)
+	server.Handle("DELETE /domain/zone/example.com/dynHost/login/example.com-router", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	logins, err := client.DynHostLogins(ctx, "example.com", &DynHostLoginFilter{SubDomain: "*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logins) != 1 || server.LastRequest().Query.Get("subDomain") != "*" {
+		t.Fatalf("unexpected logins %v", logins)
+	}
+	login, err := client.DynHostLogin(ctx, "example.com", logins[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login.SubDomain != "*" {
+		t.Fatalf("unexpected login %+v", login)
+	}
+
+	login, err = client.CreateDynHostLogin(ctx, "example.com", "router", "home", "s3cret-Pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); login.Login != "example.com-router" || body != `{"loginSuffix":"router","password":"s3cret-Pass","subDomain":"home"}` {
+		t.Fatalf("unexpected login %+v for %s", login, body)
+	}
+
+	if err := client.UpdateDynHostLogin(ctx, "example.com", login.Login, "home2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.ChangeDynHostPassword(ctx, "example.com", login.Login, "n3w-Pass"); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"password":"n3w-Pass"}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+	if err := client.DeleteDynHostLogin(ctx, "example.com", login.Login); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"net/url"
+)
+
+// GlueRecord declares at the registry the IP addresses of a name server
+// within its own domain, such as ns1.example.com for example.com.
+type GlueRecord struct {
+	Host string   `json:"host"`
+	IPs  []string `json:"ips"`
+}
+
+// GlueRecords returns the hosts of the glue records of a domain.
+func (client *Client) GlueRecords(ctx context.Context, domain string) ([]string, error) {
+	var hosts []string
+	if err := client.api.GetWithContext(ctx, domainPath(domain)+"/glueRecord", &hosts); err != nil {
+		return nil, err
+	}
+	return hosts, nil
+}
+
+// GlueRecord returns the glue record of a host of a domain.
+func (client *Client) GlueRecord(ctx context.Context, domain, host string) (*GlueRecord, error) {
+	result := &GlueRecord{}
+	if err := client.api.GetWithContext(ctx, glueRecordPath(domain, host), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateGlueRecord declares the IP addresses of a host of a domain. Whether
+// several addresses or IPv6 addresses are allowed depends on the extension
+// of the domain, see Domain.
+func (client *Client) CreateGlueRecord(ctx context.Context, domain string, record *GlueRecord) (*DomainTask, error) {
+	task := &DomainTask{}
+	if err := client.api.PostWithContext(ctx, domainPath(domain)+"/glueRecord", record, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// UpdateGlueRecord replaces the IP addresses of a glue record.
+func (client *Client) UpdateGlueRecord(ctx context.Context, domain, host string, ips []string) (*DomainTask, error) {
+	task := &DomainTask{}
+	if err := client.api.PostWithContext(ctx, glueRecordPath(domain, host)+"/update", map[string]interface{}{"ips": ips}, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// DeleteGlueRecord removes a glue record. It must not be used by a name
+// server of the domain.
+func (client *Client) DeleteGlueRecord(ctx context.Context, domain, host string) (*DomainTask, error) {
+	task := &DomainTask{}
+	if err := client.api.DeleteWithContext(ctx, glueRecordPath(domain, host), task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func glueRecordPath(domain, host string) string {
+	return domainPath(domain) + "/glueRecord/" + url.PathEscape(host)
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestGlueRecords(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain/example.com/glueRecord", 200, []string{"ns1.example.com"})
+	server.Handle("GET /domain/example.com/glueRecord/ns1.example.com", 200, map[string]interface{}{"host": "ns1.example.com", "ips": []string{"192.0.2.53", "2001:db8::53"}})
+	server.Handle("POST /domain/example.com/glueRecord", 200, map[string]interface{}{"id": 11, "function": "DomainHostCreate", "status": "todo"})
+	server.Handle("POST /domain/example.com/glueRecord/ns2.example.com/update", 200, map[string]interface{}{"id": 12, "function": "DomainHostUpdate", "status": "todo"})
+	server.Handle("DELETE /domain/example.com/glueRecord/ns2.example.com", 200, map[string]interface{}{"id": 13, "function": "DomainHostDelete", "status": "todo"})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	hosts, err := client.GlueRecords(ctx, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) != 1 {
+		t.Fatalf("unexpected hosts %v", hosts)
+	}
+	record, err := client.GlueRecord(ctx, "example.com", hosts[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Host != "ns1.example.com" || len(record.IPs) != 2 {
+		t.Fatalf("unexpected glue record %+v", record)
+	}
+
+	task, err := client.CreateGlueRecord(ctx, "example.com", &GlueRecord{Host: "ns2.example.com", IPs: []string{"192.0.2.54"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); task.ID != 11 || body != `{"host":"ns2.example.com","ips":["192.0.2.54"]}` {
+		t.Fatalf("unexpected task %+v for %s", task, body)
+	}
+
+	task, err = client.UpdateGlueRecord(ctx, "example.com", "ns2.example.com", []string{"192.0.2.55"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); task.ID != 12 || body != `{"ips":["192.0.2.55"]}` {
+		t.Fatalf("unexpected task %+v for %s", task, body)
+	}
+
+	if task, err = client.DeleteGlueRecord(ctx, "example.com", "ns2.example.com"); err != nil || task.ID != 13 {
+		t.Fatalf("unexpected task %+v (%v)", task, err)
+	}
+}
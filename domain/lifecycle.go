@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garbage-collector/ovh-go/order"
+)
+
+// NameServer is a name server of a domain, as declared at the registry.
+type NameServer struct {
+	ID   int64  `json:"id"`
+	Host string `json:"host"`
+	// IP address of the name server, for the ones within the domain.
+	IP     string `json:"ip"`
+	IsUsed bool   `json:"isUsed"`
+	// Whether the name server is being removed.
+	ToDelete       bool   `json:"toDelete"`
+	NameServerType string `json:"nameServerType"`
+}
+
+// NameServerCreation describes a name server to declare for a domain.
+type NameServerCreation struct {
+	Host string `json:"host"`
+	// IP address of the name server, required when the host is within the
+	// domain.
+	IP string `json:"ip,omitempty"`
+}
+
+// AuthInfo returns the authorization code of a domain, needed to transfer
+// it to another registrar. The transfer lock must be removed as well.
+func (client *Client) AuthInfo(ctx context.Context, domain string) (string, error) {
+	var code string
+	if err := client.api.GetWithContext(ctx, domainPath(domain)+"/authInfo", &code); err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+// LockTransfer forbids the transfer of a domain to another registrar. The
+// lock status is TransferLocking until the registry applied it.
+func (client *Client) LockTransfer(ctx context.Context, domain string) error {
+	return client.Update(ctx, domain, &DomainUpdate{TransferLockStatus: TransferLocked})
+}
+
+// UnlockTransfer allows the transfer of a domain to another registrar. The
+// lock status is TransferUnlocking until the registry applied it.
+func (client *Client) UnlockTransfer(ctx context.Context, domain string) error {
+	return client.Update(ctx, domain, &DomainUpdate{TransferLockStatus: TransferUnlocked})
+}
+
+// Renew orders the renewal of a domain for duration, such as P1Y. With
+// dryRun, the order is only priced. The returned order must be paid for
+// the renewal to happen, see me.Client.PayOrder.
+func (client *Client) Renew(ctx context.Context, domain, duration string, dryRun bool) (*order.Order, error) {
+	body := map[string]interface{}{"duration": duration, "dryRun": dryRun}
+
+	result := &order.Order{}
+	if err := client.api.PostWithContext(ctx, domainPath(domain)+"/renew", body, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NameServers returns the identifiers of the name servers of a domain.
+func (client *Client) NameServers(ctx context.Context, domain string) ([]int64, error) {
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, domainPath(domain)+"/nameServer", &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// NameServer returns a name server of a domain.
+func (client *Client) NameServer(ctx context.Context, domain string, id int64) (*NameServer, error) {
+	result := &NameServer{}
+	if err := client.api.GetWithContext(ctx, nameServerPath(domain, id), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AddNameServers declares more name servers for a domain.
+func (client *Client) AddNameServers(ctx context.Context, domain string, nameServers []*NameServerCreation) (*DomainTask, error) {
+	task := &DomainTask{}
+	if err := client.api.PostWithContext(ctx, domainPath(domain)+"/nameServer", map[string]interface{}{"nameServer": nameServers}, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// DeleteNameServer removes a name server of a domain.
+func (client *Client) DeleteNameServer(ctx context.Context, domain string, id int64) (*DomainTask, error) {
+	task := &DomainTask{}
+	if err := client.api.DeleteWithContext(ctx, nameServerPath(domain, id), task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// UpdateNameServers replaces the name servers of a domain. The name server
+// type of the domain must be NameServerExternal to use other name servers
+// than the ones of OVH.
+func (client *Client) UpdateNameServers(ctx context.Context, domain string, nameServers []*NameServerCreation) (*DomainTask, error) {
+	task := &DomainTask{}
+	if err := client.api.PostWithContext(ctx, domainPath(domain)+"/nameServers/update", map[string]interface{}{"nameServers": nameServers}, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func nameServerPath(domain string, id int64) string {
+	return fmt.Sprintf("%s/nameServer/%d", domainPath(domain), id)
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestTransfer(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain/example.com/authInfo", 200, "Xk9#2pLq")
+	server.Handle("PUT /domain/example.com", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	code, err := client.AuthInfo(ctx, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != "Xk9#2pLq" {
+		t.Fatalf("unexpected code %q", code)
+	}
+
+	if err := client.UnlockTransfer(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"transferLockStatus":"unlocked"}` {
+		t.Fatalf("unexpected update %s", body)
+	}
+	if err := client.LockTransfer(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"transferLockStatus":"locked"}` {
+		t.Fatalf("unexpected update %s", body)
+	}
+}
+
+func TestRenew(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("POST /domain/example.com/renew", 200, map[string]interface{}{
+		"orderId": 1234,
+		"url":     "https://www.ovh.com/cgi-bin/order/displayOrder.cgi?orderId=1234",
+		"prices":  map[string]interface{}{"withTax": map[string]interface{}{"currencyCode": "EUR", "value": 9.59, "text": "9.59 €"}},
+	})
+
+	client := New(server.Caller())
+	result, err := client.Renew(context.Background(), "example.com", "P1Y", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.OrderID != 1234 || result.Prices.WithTax.CurrencyCode != "EUR" {
+		t.Fatalf("unexpected order %+v", result)
+	}
+	if body := string(server.LastRequest().Body); body != `{"dryRun":false,"duration":"P1Y"}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+}
+
+func TestNameServers(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain/example.com/nameServer", 200, []int64{1, 2})
+	server.Handle("GET /domain/example.com/nameServer/1", 200, map[string]interface{}{"id": 1, "host": "dns200.anycast.me", "isUsed": true, "nameServerType": "hosted"})
+	server.Handle("POST /domain/example.com/nameServer", 200, map[string]interface{}{"id": 5, "function": "DomainDnsUpdate", "status": "todo"})
+	server.Handle("DELETE /domain/example.com/nameServer/2", 200, map[string]interface{}{"id": 6, "function": "DomainDnsUpdate", "status": "todo"})
+	server.Handle("POST /domain/example.com/nameServers/update", 200, map[string]interface{}{"id": 7, "function": "DomainDnsUpdate", "status": "todo"})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	ids, err := client.NameServers(ctx, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("unexpected name servers %v", ids)
+	}
+	nameServer, err := client.NameServer(ctx, "example.com", ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nameServer.Host != "dns200.anycast.me" || !nameServer.IsUsed || nameServer.NameServerType != NameServerHosted {
+		t.Fatalf("unexpected name server %+v", nameServer)
+	}
+
+	task, err := client.AddNameServers(ctx, "example.com", []*NameServerCreation{{Host: "ns1.example.com", IP: "192.0.2.53"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); task.ID != 5 || body != `{"nameServer":[{"host":"ns1.example.com","ip":"192.0.2.53"}]}` {
+		t.Fatalf("unexpected task %+v for %s", task, body)
+	}
+
+	if task, err = client.DeleteNameServer(ctx, "example.com", 2); err != nil || task.ID != 6 {
+		t.Fatalf("unexpected task %+v (%v)", task, err)
+	}
+
+	task, err = client.UpdateNameServers(ctx, "example.com", []*NameServerCreation{{Host: "ns1.example.net"}, {Host: "ns2.example.net"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); task.ID != 7 || body != `{"nameServers":[{"host":"ns1.example.net"},{"host":"ns2.example.net"}]}` {
+		t.Fatalf("unexpected task %+v for %s", task, body)
+	}
+}
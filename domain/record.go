@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Record is a resource record of a DNS zone.
+type Record struct {
+	ID   int64  `json:"id,omitempty"`
+	Zone string `json:"zone,omitempty"`
+	// Type of the record, such as A, CNAME or TXT.
+	FieldType string `json:"fieldType"`
+	// Name of the record relative to the zone, empty for the zone apex.
+	SubDomain string `json:"subDomain"`
+	Target    string `json:"target"`
+	// Time to live in seconds, 0 for the default of the zone.
+	TTL int64 `json:"ttl"`
+}
+
+// RecordFilter filters the records returned by Records.
+type RecordFilter struct {
+	FieldType string `url:"fieldType,omitempty"`
+	SubDomain string `url:"subDomain,omitempty"`
+}
+
+// Records returns the identifiers of the records of a zone. A nil filter
+// returns all of them.
+func (client *Client) Records(ctx context.Context, zone string, filter *RecordFilter) ([]int64, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, zonePath(zone)+"/record", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Record returns a record of a zone.
+func (client *Client) Record(ctx context.Context, zone string, recordID int64) (*Record, error) {
+	record := &Record{}
+	if err := client.api.GetWithContext(ctx, recordPath(zone, recordID), record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// CreateRecord adds a record to a zone, and returns it with its identifier.
+// The zone must be refreshed for the record to be served.
+func (client *Client) CreateRecord(ctx context.Context, zone string, record *Record) (*Record, error) {
+	body := map[string]interface{}{"fieldType": record.FieldType, "subDomain": record.SubDomain, "target": record.Target}
+	if record.TTL != 0 {
+		body["ttl"] = record.TTL
+	}
+
+	created := &Record{}
+	if err := client.api.PostWithContext(ctx, zonePath(zone)+"/record", body, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateRecord modifies the name, target and TTL of a record. Its type can't
+// be changed. The zone must be refreshed for the change to be served.
+func (client *Client) UpdateRecord(ctx context.Context, zone string, record *Record) error {
+	body := map[string]interface{}{"subDomain": record.SubDomain, "target": record.Target, "ttl": record.TTL}
+	return client.api.PutWithContext(ctx, recordPath(zone, record.ID), body, nil)
+}
+
+// DeleteRecord removes a record from a zone. The zone must be refreshed for
+// the record to stop being served.
+func (client *Client) DeleteRecord(ctx context.Context, zone string, recordID int64) error {
+	return client.api.DeleteWithContext(ctx, recordPath(zone, recordID), nil)
+}
+
+// EnsureRecord makes sure a zone has a record of the type, name and target
+// of record, creating it if missing, or updating its TTL when record has
+// one. Other records are left untouched. It returns the record with its
+// identifier, and whether the zone changed and must be refreshed.
+func (client *Client) EnsureRecord(ctx context.Context, zone string, record *Record) (*Record, bool, error) {
+	return client.upsertRecord(ctx, zone, record, func(current *Record) bool {
+		return current.Target == record.Target
+	})
+}
+
+// ReplaceRecords makes record the only record of its type and name in a
+// zone: the first existing one is updated if needed, and the others are
+// removed. This is destructive for names with several records of a type,
+// such as A records for round-robin or TXT records, where EnsureRecord
+// should be used instead. It returns the record with its identifier, and
+// whether the zone changed and must be refreshed.
+func (client *Client) ReplaceRecords(ctx context.Context, zone string, record *Record) (*Record, bool, error) {
+	existing, err := client.recordsOf(ctx, zone, record.FieldType, record.SubDomain)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(existing) == 0 {
+		created, err := client.CreateRecord(ctx, zone, record)
+		return created, err == nil, err
+	}
+
+	kept, changed, err := client.updateRecord(ctx, zone, existing[0], record)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, extra := range existing[1:] {
+		if err := client.DeleteRecord(ctx, zone, extra.ID); err != nil {
+			return nil, changed, err
+		}
+		changed = true
+	}
+	return kept, changed, nil
+}
+
+// upsertRecord updates the first record of the type and name of record
+// accepted by match, or creates record if there is none.
+func (client *Client) upsertRecord(ctx context.Context, zone string, record *Record, match func(*Record) bool) (*Record, bool, error) {
+	existing, err := client.recordsOf(ctx, zone, record.FieldType, record.SubDomain)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, current := range existing {
+		if match(current) {
+			return client.updateRecord(ctx, zone, current, record)
+		}
+	}
+
+	created, err := client.CreateRecord(ctx, zone, record)
+	return created, err == nil, err
+}
+
+// updateRecord sets the target and TTL of record to current, unless they
+// already match, and returns it with whether it changed.
+func (client *Client) updateRecord(ctx context.Context, zone string, current, record *Record) (*Record, bool, error) {
+	if current.Target == record.Target && (record.TTL == 0 || current.TTL == record.TTL) {
+		return current, false, nil
+	}
+	current.Target = record.Target
+	if record.TTL != 0 {
+		current.TTL = record.TTL
+	}
+	if err := client.UpdateRecord(ctx, zone, current); err != nil {
+		return nil, false, err
+	}
+	return current, true, nil
+}
+
+// recordsOf returns the records of a type and name of a zone.
+func (client *Client) recordsOf(ctx context.Context, zone, fieldType, subDomain string) ([]*Record, error) {
+	ids, err := client.Records(ctx, zone, &RecordFilter{FieldType: fieldType, SubDomain: subDomain})
+	if err != nil {
+		return nil, err
+	}
+	// An empty subDomain filter matches every name: keep the records of
+	// the requested name only.
+	var records []*Record
+	for _, id := range ids {
+		record, err := client.Record(ctx, zone, id)
+		if err != nil {
+			return nil, err
+		}
+		if record.SubDomain == subDomain {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func recordPath(zone string, recordID int64) string {
+	return fmt.Sprintf("%s/record/%d", zonePath(zone), recordID)
+}
@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+// fakeZone serves the records of a zone from memory.
+type fakeZone struct {
+	records map[int64]*Record
+	nextID  int64
+}
+
+func newFakeZone(server *govhtest.Server, records ...*Record) *fakeZone {
+	zone := &fakeZone{records: map[int64]*Record{}, nextID: 1}
+	for _, record := range records {
+		record.ID = zone.nextID
+		zone.records[record.ID] = record
+		zone.nextID++
+	}
+
+	server.HandleFunc("GET /domain/zone/example.com/record", func(w http.ResponseWriter, r *http.Request) {
+		ids := []int64{}
+		for id, record := range zone.records {
+			fieldType, subDomain := r.URL.Query().Get("fieldType"), r.URL.Query().Get("subDomain")
+			if (fieldType == "" || record.FieldType == fieldType) && (subDomain == "" || record.SubDomain == subDomain) {
+				ids = append(ids, id)
+			}
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		json.NewEncoder(w).Encode(ids)
+	})
+	server.HandleFunc("POST /domain/zone/example.com/record", func(w http.ResponseWriter, r *http.Request) {
+		record := &Record{Zone: "example.com"}
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, record)
+		record.ID = zone.nextID
+		zone.nextID++
+		zone.records[record.ID] = record
+		json.NewEncoder(w).Encode(record)
+	})
+	server.HandleFunc("/domain/zone/example.com/record/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.ParseInt(r.PathValue("id"), 10, 64)
+		record, ok := zone.records[id]
+		if !ok {
+			govhtest.WriteError(w, 404, "Client::NotFound", "Record not found")
+			return
+		}
+		switch r.Method {
+		case "GET":
+			json.NewEncoder(w).Encode(record)
+		case "PUT":
+			body, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(body, record)
+			w.Write([]byte("null"))
+		case "DELETE":
+			delete(zone.records, id)
+			w.Write([]byte("null"))
+		}
+	})
+	return zone
+}
+
+func TestRecords(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	newFakeZone(server,
+		&Record{FieldType: "A", SubDomain: "www", Target: "192.0.2.1", TTL: 3600},
+		&Record{FieldType: "TXT", SubDomain: "", Target: "\"v=spf1 -all\""},
+	)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	ids, err := client.Records(ctx, "example.com", &RecordFilter{FieldType: "A"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 || server.LastRequest().Query.Get("fieldType") != "A" {
+		t.Fatalf("unexpected records %v", ids)
+	}
+
+	record, err := client.Record(ctx, "example.com", ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.SubDomain != "www" || record.TTL != 3600 {
+		t.Fatalf("unexpected record %+v", record)
+	}
+
+	created, err := client.CreateRecord(ctx, "example.com", &Record{FieldType: "CNAME", SubDomain: "blog", Target: "www.example.com."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); created.ID != 3 || body != `{"fieldType":"CNAME","subDomain":"blog","target":"www.example.com."}` {
+		t.Fatalf("unexpected record %+v created with %s", created, body)
+	}
+
+	created.Target = "blog.example.net."
+	if err := client.UpdateRecord(ctx, "example.com", created); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.DeleteRecord(ctx, "example.com", created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Record(ctx, "example.com", created.ID); err == nil {
+		t.Fatal("expected the record to be deleted")
+	}
+}
+
+func TestEnsureRecord(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	zone := newFakeZone(server,
+		&Record{FieldType: "A", SubDomain: "www", Target: "192.0.2.1", TTL: 3600},
+		&Record{FieldType: "A", SubDomain: "www", Target: "192.0.2.2", TTL: 3600},
+		&Record{FieldType: "A", SubDomain: "", Target: "192.0.2.2"},
+	)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	record, changed, err := client.EnsureRecord(ctx, "example.com", &Record{FieldType: "A", SubDomain: "www", Target: "192.0.2.3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || record.ID != 4 || len(zone.records) != 4 || zone.records[1].Target != "192.0.2.1" || zone.records[2].Target != "192.0.2.2" {
+		t.Fatalf("unexpected record %+v, changed %v, zone %v", record, changed, zone.records)
+	}
+
+	_, changed, err = client.EnsureRecord(ctx, "example.com", &Record{FieldType: "A", SubDomain: "www", Target: "192.0.2.3"})
+	if err != nil || changed {
+		t.Fatalf("expected no change, got %v, %v", changed, err)
+	}
+
+	record, changed, err = client.EnsureRecord(ctx, "example.com", &Record{FieldType: "A", SubDomain: "www", Target: "192.0.2.2", TTL: 60})
+	if err != nil || !changed || record.ID != 2 || zone.records[2].TTL != 60 {
+		t.Fatalf("expected the TTL to be updated, got %+v, %v, %v", record, changed, err)
+	}
+
+	record, changed, err = client.EnsureRecord(ctx, "example.com", &Record{FieldType: "A", SubDomain: "", Target: "192.0.2.2"})
+	if err != nil || changed || record.ID != 3 {
+		t.Fatalf("expected the apex record to be kept, got %+v, %v, %v", record, changed, err)
+	}
+}
+
+func TestReplaceRecords(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	zone := newFakeZone(server,
+		&Record{FieldType: "A", SubDomain: "www", Target: "192.0.2.1", TTL: 3600},
+		&Record{FieldType: "A", SubDomain: "www", Target: "192.0.2.2", TTL: 3600},
+		&Record{FieldType: "A", SubDomain: "", Target: "192.0.2.9"},
+	)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	record, changed, err := client.ReplaceRecords(ctx, "example.com", &Record{FieldType: "A", SubDomain: "www", Target: "192.0.2.3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || record.ID != 1 || len(zone.records) != 2 || zone.records[1].Target != "192.0.2.3" || zone.records[1].TTL != 3600 {
+		t.Fatalf("unexpected record %+v, changed %v, zone %v", record, changed, zone.records)
+	}
+
+	_, changed, err = client.ReplaceRecords(ctx, "example.com", &Record{FieldType: "A", SubDomain: "www", Target: "192.0.2.3"})
+	if err != nil || changed {
+		t.Fatalf("expected no change, got %v, %v", changed, err)
+	}
+
+	record, changed, err = client.ReplaceRecords(ctx, "example.com", &Record{FieldType: "A", SubDomain: "", Target: "192.0.2.9"})
+	if err != nil || changed || record.ID != 3 {
+		t.Fatalf("expected the apex record to be kept, got %+v, %v, %v", record, changed, err)
+	}
+
+	record, changed, err = client.ReplaceRecords(ctx, "example.com", &Record{FieldType: "AAAA", SubDomain: "www", Target: "2001:db8::1"})
+	if err != nil || !changed || record.ID != 4 {
+		t.Fatalf("expected a record to be created, got %+v, %v, %v", record, changed, err)
+	}
+}
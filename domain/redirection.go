@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Redirection types.
+const (
+	// RedirectionVisible redirects with a 302 status.
+	RedirectionVisible = "visible"
+	// RedirectionVisiblePermanent redirects with a 301 status.
+	RedirectionVisiblePermanent = "visiblePermanent"
+	// RedirectionInvisible serves the target in a frame, keeping the
+	// address shown by browsers.
+	RedirectionInvisible = "invisible"
+)
+
+// Redirection is a web redirection of a name of a zone, served by OVH.
+type Redirection struct {
+	ID   int64  `json:"id,omitempty"`
+	Zone string `json:"zone,omitempty"`
+	// Name redirected relative to the zone, empty for the zone apex.
+	SubDomain string `json:"subDomain"`
+	Target    string `json:"target"`
+	// Type is one of the Redirection constants.
+	Type string `json:"type"`
+	// Page properties of invisible redirections.
+	Title       string `json:"title,omitempty"`
+	Keywords    string `json:"keywords,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// RedirectionFilter filters the redirections returned by Redirections.
+type RedirectionFilter struct {
+	SubDomain string `url:"subDomain,omitempty"`
+}
+
+// Redirections returns the identifiers of the redirections of a zone. A nil
+// filter returns all of them.
+func (client *Client) Redirections(ctx context.Context, zone string, filter *RedirectionFilter) ([]int64, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, zonePath(zone)+"/redirection", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Redirection returns a redirection of a zone.
+func (client *Client) Redirection(ctx context.Context, zone string, id int64) (*Redirection, error) {
+	result := &Redirection{}
+	if err := client.api.GetWithContext(ctx, redirectionPath(zone, id), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateRedirection adds a redirection to a zone, and returns it with its
+// identifier. The zone must be refreshed for the redirection to be served.
+func (client *Client) CreateRedirection(ctx context.Context, zone string, redirection *Redirection) (*Redirection, error) {
+	created := &Redirection{}
+	if err := client.api.PostWithContext(ctx, zonePath(zone)+"/redirection", redirection, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateRedirection modifies the target and page properties of a
+// redirection. Its name and type can't be changed.
+func (client *Client) UpdateRedirection(ctx context.Context, zone string, redirection *Redirection) error {
+	body := map[string]interface{}{
+		"target":      redirection.Target,
+		"title":       redirection.Title,
+		"keywords":    redirection.Keywords,
+		"description": redirection.Description,
+	}
+	return client.api.PutWithContext(ctx, redirectionPath(zone, redirection.ID), body, nil)
+}
+
+// DeleteRedirection removes a redirection from a zone.
+func (client *Client) DeleteRedirection(ctx context.Context, zone string, id int64) error {
+	return client.api.DeleteWithContext(ctx, redirectionPath(zone, id), nil)
+}
+
+func redirectionPath(zone string, id int64) string {
+	return fmt.Sprintf("%s/redirection/%d", zonePath(zone), id)
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestRedirections(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain/zone/example.com/redirection", 200, []int64{8})
+	server.Handle("GET /domain/zone/example.com/redirection/8", 200, map[string]interface{}{"id": 8, "zone": "example.com", "subDomain": "", "target": "https://www.example.com/", "type": "visiblePermanent"})
+	server.Handle("POST /domain/zone/example.com/redirection", 200, map[string]interface{}{"id": 9, "zone": "example.com", "subDomain": "blog", "target": "https://blog.example.net/", "type": "visible"})
+	server.Handle("PUT /domain/zone/example.com/redirection/8", 200, nil)
+	server.Handle("DELETE /domain/zone/example.com/redirection/9", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	ids, err := client.Redirections(ctx, "example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 {
+		t.Fatalf("unexpected redirections %v", ids)
+	}
+	redirection, err := client.Redirection(ctx, "example.com", ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redirection.Type != RedirectionVisiblePermanent || redirection.Target != "https://www.example.com/" {
+		t.Fatalf("unexpected redirection %+v", redirection)
+	}
+
+	created, err := client.CreateRedirection(ctx, "example.com", &Redirection{SubDomain: "blog", Target: "https://blog.example.net/", Type: RedirectionVisible})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); created.ID != 9 || body != `{"subDomain":"blog","target":"https://blog.example.net/","type":"visible"}` {
+		t.Fatalf("unexpected redirection %+v for %s", created, body)
+	}
+
+	redirection.Target = "https://example.org/"
+	if err := client.UpdateRedirection(ctx, "example.com", redirection); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"description":"","keywords":"","target":"https://example.org/","title":""}` {
+		t.Fatalf("unexpected update %s", body)
+	}
+	if err := client.DeleteRedirection(ctx, "example.com", created.ID); err != nil {
+		t.Fatal(err)
+	}
+}
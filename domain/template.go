@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"context"
+	"strings"
+)
+
+// Template is a set of records and redirections to roll out to zones, see
+// ApplyTemplate.
+type Template struct {
+	Records      []*Record
+	Redirections []*Redirection
+}
+
+// SPFRecord returns the SPF record of the zone apex allowing the given
+// mechanisms, such as include:mx.ovh.com, and failing softly for the other
+// senders.
+func SPFRecord(mechanisms ...string) *Record {
+	fields := append([]string{"v=spf1"}, mechanisms...)
+	return &Record{FieldType: "TXT", Target: `"` + strings.Join(append(fields, "~all"), " ") + `"`}
+}
+
+// DKIMRecord returns the record publishing the public key of a DKIM
+// selector, encoded in base64.
+func DKIMRecord(selector, publicKey string) *Record {
+	return &Record{FieldType: "TXT", SubDomain: selector + "._domainkey", Target: `"v=DKIM1; k=rsa; p=` + publicKey + `"`}
+}
+
+// ApplyTemplate rolls out a template to a zone, leaving the other records of
+// the zone untouched. Each record is matched with the existing records of
+// its type and name on a key, and updated or created:
+//   - SPF and DKIM TXT records match the TXT record starting with v=spf1 or
+//     v=DKIM1, such that other TXT records, e.g. site verifications, are
+//     kept;
+//   - CNAME records match the CNAME record of their name;
+//   - other records match the record with the same target, and are only
+//     created when missing, see EnsureRecord.
+//
+// Each redirection replaces the redirection of its name. The zone is
+// refreshed if it changed, and whether it did is returned.
+func (client *Client) ApplyTemplate(ctx context.Context, zone string, template *Template) (bool, error) {
+	changed := false
+	for _, record := range template.Records {
+		_, recordChanged, err := client.upsertRecord(ctx, zone, record, templateMatch(record))
+		changed = changed || recordChanged
+		if err != nil {
+			return changed, err
+		}
+	}
+
+	for _, redirection := range template.Redirections {
+		redirectionChanged, err := client.ensureRedirection(ctx, zone, redirection)
+		changed = changed || redirectionChanged
+		if err != nil {
+			return changed, err
+		}
+	}
+
+	if changed {
+		return true, client.Refresh(ctx, zone)
+	}
+	return false, nil
+}
+
+// templateMatch returns the function matching the existing record a record
+// of a template replaces, see ApplyTemplate.
+func templateMatch(record *Record) func(*Record) bool {
+	switch record.FieldType {
+	case "TXT":
+		if key := txtKey(record.Target); key != "" {
+			return func(current *Record) bool { return txtKey(current.Target) == key }
+		}
+	case "CNAME":
+		return func(*Record) bool { return true }
+	}
+	return func(current *Record) bool { return current.Target == record.Target }
+}
+
+// txtKey returns the version tag of an SPF or DKIM TXT record, such as
+// v=spf1, or an empty string for other TXT records.
+func txtKey(target string) string {
+	fields := strings.Fields(strings.Trim(target, `"`))
+	if len(fields) == 0 {
+		return ""
+	}
+	key := strings.ToLower(strings.TrimSuffix(fields[0], ";"))
+	if key == "v=spf1" || key == "v=dkim1" {
+		return key
+	}
+	return ""
+}
+
+// ensureRedirection makes redirection the only redirection of its name in a
+// zone, and returns whether the zone changed.
+func (client *Client) ensureRedirection(ctx context.Context, zone string, redirection *Redirection) (bool, error) {
+	ids, err := client.Redirections(ctx, zone, &RedirectionFilter{SubDomain: redirection.SubDomain})
+	if err != nil {
+		return false, err
+	}
+
+	changed := false
+	found := false
+	for _, id := range ids {
+		current, err := client.Redirection(ctx, zone, id)
+		if err != nil {
+			return changed, err
+		}
+		// An empty subDomain filter matches every name.
+		if current.SubDomain != redirection.SubDomain {
+			continue
+		}
+
+		switch {
+		case !found && current.Type == redirection.Type:
+			found = true
+			if current.Target == redirection.Target && current.Title == redirection.Title && current.Keywords == redirection.Keywords && current.Description == redirection.Description {
+				continue
+			}
+			update := *redirection
+			update.ID = current.ID
+			if err := client.UpdateRedirection(ctx, zone, &update); err != nil {
+				return changed, err
+			}
+		default:
+			if err := client.DeleteRedirection(ctx, zone, current.ID); err != nil {
+				return changed, err
+			}
+		}
+		changed = true
+	}
+
+	if !found {
+		if _, err := client.CreateRedirection(ctx, zone, redirection); err != nil {
+			return changed, err
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// ResetZone removes the records and redirections of a zone. Unless
+// minimized is set, the default records of OVH, such as those of the web
+// hosting and mail servers, are created again. The A and MX records given
+// replace the default ones.
+func (client *Client) ResetZone(ctx context.Context, zone string, minimized bool, records ...*Record) error {
+	resetRecords := make([]map[string]interface{}, len(records))
+	for i, record := range records {
+		resetRecords[i] = map[string]interface{}{"fieldType": record.FieldType, "target": record.Target}
+	}
+	body := map[string]interface{}{"minimized": minimized, "DnsRecords": resetRecords}
+	return client.api.PostWithContext(ctx, zonePath(zone)+"/reset", body, nil)
+}
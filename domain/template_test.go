@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestApplyTemplate(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	zone := newFakeZone(server,
+		&Record{FieldType: "TXT", SubDomain: "", Target: `"google-site-verification=abc123"`},
+		&Record{FieldType: "TXT", SubDomain: "", Target: `"v=spf1 -all"`},
+		&Record{FieldType: "TXT", SubDomain: "ovh._domainkey", Target: `"v=DKIM1; k=rsa; p=MIIB"`},
+	)
+	server.Handle("GET /domain/zone/example.com/redirection", 200, []int64{5, 6})
+	server.Handle("GET /domain/zone/example.com/redirection/5", 200, map[string]interface{}{"id": 5, "subDomain": "", "target": "http://old.example.net/", "type": "visible"})
+	server.Handle("GET /domain/zone/example.com/redirection/6", 200, map[string]interface{}{"id": 6, "subDomain": "", "target": "http://old.example.net/", "type": "invisible"})
+	server.Handle("PUT /domain/zone/example.com/redirection/5", 200, nil)
+	server.Handle("DELETE /domain/zone/example.com/redirection/6", 200, nil)
+	server.Handle("POST /domain/zone/example.com/refresh", 200, nil)
+
+	template := &Template{
+		Records: []*Record{
+			SPFRecord("include:mx.ovh.com"),
+			DKIMRecord("ovh", "MIIB"),
+		},
+		Redirections: []*Redirection{{Target: "https://www.example.com/", Type: RedirectionVisible}},
+	}
+	if template.Records[0].Target != `"v=spf1 include:mx.ovh.com ~all"` {
+		t.Fatalf("unexpected SPF record %+v", template.Records[0])
+	}
+
+	client := New(server.Caller())
+	changed, err := client.ApplyTemplate(context.Background(), "example.com", template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Fatal("expected the zone to change")
+	}
+	if zone.records[1].Target != `"google-site-verification=abc123"` || zone.records[2].Target != `"v=spf1 include:mx.ovh.com ~all"` || len(zone.records) != 3 {
+		t.Fatalf("unexpected records %+v", zone.records)
+	}
+
+	var methods []string
+	for _, request := range server.Requests() {
+		if request.Method != "GET" {
+			methods = append(methods, request.Method+" "+request.Path)
+		}
+	}
+	expected := []string{
+		"PUT /domain/zone/example.com/record/2",
+		"PUT /domain/zone/example.com/redirection/5",
+		"DELETE /domain/zone/example.com/redirection/6",
+		"POST /domain/zone/example.com/refresh",
+	}
+	if len(methods) != len(expected) {
+		t.Fatalf("unexpected requests %v", methods)
+	}
+	for i := range expected {
+		if methods[i] != expected[i] {
+			t.Fatalf("unexpected requests %v", methods)
+		}
+	}
+}
+
+func TestApplyTemplateKeepsRecords(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	zone := newFakeZone(server,
+		&Record{FieldType: "TXT", SubDomain: "", Target: `"ms=ms12345"`},
+		&Record{FieldType: "MX", SubDomain: "", Target: "10 mx1.example.net."},
+		&Record{FieldType: "CNAME", SubDomain: "www", Target: "old.example.net."},
+	)
+	server.Handle("POST /domain/zone/example.com/refresh", 200, nil)
+
+	template := &Template{
+		Records: []*Record{
+			SPFRecord("include:mx.ovh.com"),
+			{FieldType: "MX", Target: "10 mx1.example.net."},
+			{FieldType: "MX", Target: "20 mx2.example.net."},
+			{FieldType: "CNAME", SubDomain: "www", Target: "example.com."},
+		},
+	}
+
+	client := New(server.Caller())
+	changed, err := client.ApplyTemplate(context.Background(), "example.com", template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || len(zone.records) != 5 {
+		t.Fatalf("unexpected records %+v", zone.records)
+	}
+	if zone.records[1].Target != `"ms=ms12345"` || zone.records[2].Target != "10 mx1.example.net." || zone.records[3].Target != "example.com." {
+		t.Fatalf("unexpected records %+v %+v %+v", zone.records[1], zone.records[2], zone.records[3])
+	}
+	if zone.records[4].Target != `"v=spf1 include:mx.ovh.com ~all"` || zone.records[5].Target != "20 mx2.example.net." {
+		t.Fatalf("unexpected records %+v %+v", zone.records[4], zone.records[5])
+	}
+}
+
+func TestResetZone(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("POST /domain/zone/example.com/reset", 200, nil)
+
+	client := New(server.Caller())
+	if err := client.ResetZone(context.Background(), "example.com", true, &Record{FieldType: "A", Target: "192.0.2.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"DnsRecords":[{"fieldType":"A","target":"192.0.2.1"}],"minimized":true}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Zone is a DNS zone hosted by OVH.
+type Zone struct {
+	Name            string        `json:"name"`
+	NameServers     []string      `json:"nameServers"`
+	DNSSECSupported bool          `json:"dnssecSupported"`
+	HasDNSAnycast   bool          `json:"hasDnsAnycast"`
+	LastUpdate      govh.DateTime `json:"lastUpdate"`
+}
+
+// Zones returns the DNS zones of the account.
+func (client *Client) Zones(ctx context.Context) ([]string, error) {
+	var zones []string
+	if err := client.api.GetWithContext(ctx, "/domain/zone", &zones); err != nil {
+		return nil, err
+	}
+	return zones, nil
+}
+
+// Zone returns the details of a DNS zone.
+func (client *Client) Zone(ctx context.Context, zone string) (*Zone, error) {
+	result := &Zone{}
+	if err := client.api.GetWithContext(ctx, zonePath(zone), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Refresh applies the changes made to the records of a zone, which are not
+// served by the name servers until then.
+func (client *Client) Refresh(ctx context.Context, zone string) error {
+	return client.api.PostWithContext(ctx, zonePath(zone)+"/refresh", nil, nil)
+}
+
+func zonePath(zone string) string {
+	return "/domain/zone/" + url.PathEscape(zone)
+}
+
+// ZoneTask is an operation on a zone run asynchronously by the API, such as
+// an import.
+type ZoneTask struct {
+	ID       int64  `json:"id"`
+	Function string `json:"function"`
+	// Status of the task: cancelled, doing, done, error or todo.
+	Status       string        `json:"status"`
+	Comment      string        `json:"comment"`
+	CreationDate govh.DateTime `json:"creationDate"`
+	TodoDate     govh.DateTime `json:"todoDate"`
+	DoneDate     govh.DateTime `json:"doneDate"`
+	LastUpdate   govh.DateTime `json:"lastUpdate"`
+}
+
+// WaitForZoneTask waits for a task of a zone to end. A *govh.TaskError is
+// returned if it failed. A nil options uses the defaults of
+// govh.WaitForTask.
+func (client *Client) WaitForZoneTask(ctx context.Context, zone string, taskID int64, options *govh.TaskOptions) (*ZoneTask, error) {
+	return govh.WaitForTaskPath[*ZoneTask](ctx, client.api, fmt.Sprintf("%s/task/%d", zonePath(zone), taskID), options)
+}
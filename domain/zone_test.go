@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestZones(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.HandleFixtures()
+	server.Handle("POST /domain/zone/example.com/refresh", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	zones, err := client.Zones(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zones) != 1 {
+		t.Fatalf("unexpected zones %v", zones)
+	}
+
+	zone, err := client.Zone(ctx, zones[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zone.Name != "example.com" || len(zone.NameServers) != 2 || !zone.DNSSECSupported {
+		t.Fatalf("unexpected zone %+v", zone)
+	}
+
+	if err := client.Refresh(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if request := server.LastRequest(); request.Method != "POST" || request.Path != "/domain/zone/example.com/refresh" {
+		t.Fatalf("unexpected request %s %s", request.Method, request.Path)
+	}
+}
+
+func TestZoneFixture(t *testing.T) {
+	govhtest.DecodeFixture(t, "domain_zone", &Zone{})
+}
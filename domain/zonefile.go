@@ -0,0 +1,275 @@
+package domain
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Export returns a zone in the BIND zone file format. The API returns it as
+// a JSON string, but may serve it as plain text, which is returned as is.
+func (client *Client) Export(ctx context.Context, zone string) (string, error) {
+	response, err := client.api.CallAPIRaw(ctx, zonePath(zone)+"/export", "GET", nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var text string
+	if json.Unmarshal(response.Body, &text) == nil {
+		return text, nil
+	}
+	return string(response.Body), nil
+}
+
+// Import replaces the records of a zone by those of a BIND zone file, and
+// returns the task applying them. See WaitForZoneTask.
+func (client *Client) Import(ctx context.Context, zone, zoneFile string) (*ZoneTask, error) {
+	task := &ZoneTask{}
+	body := map[string]interface{}{"zoneFile": zoneFile}
+	if err := client.api.PostWithContext(ctx, zonePath(zone)+"/import", body, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// ParseZone reads the records of a BIND zone file, such as returned by
+// Export. Names are made relative to zone, and records outside of it are
+// ignored. $ORIGIN and $TTL directives, comments and parenthesized records
+// spanning several lines are supported; $INCLUDE and $GENERATE are not.
+func ParseZone(r io.Reader, zone string) ([]*Record, error) {
+	origin := fqdn(zone)
+	var records []*Record
+	var defaultTTL int64
+	var previousName string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	lineNumber := 0
+	var pending []string
+	depth := 0
+	for scanner.Scan() {
+		lineNumber++
+		line, open := stripComment(scanner.Text())
+		depth += open
+		pending = append(pending, line)
+		if depth > 0 {
+			continue
+		}
+		line = strings.Join(pending, " ")
+		pending = pending[:0]
+
+		startsBlank := line != "" && (line[0] == ' ' || line[0] == '\t')
+		fields := splitFields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("Invalid zone file, line %d: $ORIGIN without name", lineNumber)
+			}
+			origin = absoluteName(fields[1], origin)
+			continue
+		case "$TTL":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("Invalid zone file, line %d: $TTL without value", lineNumber)
+			}
+			ttl, ok := parseTTL(fields[1])
+			if !ok {
+				return nil, fmt.Errorf("Invalid zone file, line %d: invalid $TTL %q", lineNumber, fields[1])
+			}
+			defaultTTL = ttl
+			continue
+		}
+		if strings.HasPrefix(fields[0], "$") {
+			return nil, fmt.Errorf("Invalid zone file, line %d: unsupported directive %s", lineNumber, fields[0])
+		}
+
+		name := previousName
+		if !startsBlank {
+			name = absoluteName(fields[0], origin)
+			fields = fields[1:]
+		}
+		previousName = name
+
+		// The TTL and class are optional, in any order. Types never start
+		// with a digit, unlike TTLs.
+		ttl := defaultTTL
+		for len(fields) > 0 {
+			if fields[0][0] >= '0' && fields[0][0] <= '9' {
+				n, ok := parseTTL(fields[0])
+				if !ok {
+					return nil, fmt.Errorf("Invalid zone file, line %d: invalid TTL %q", lineNumber, fields[0])
+				}
+				ttl = n
+			} else if strings.EqualFold(fields[0], "IN") {
+				// Only the IN class is used.
+			} else {
+				break
+			}
+			fields = fields[1:]
+		}
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("Invalid zone file, line %d: record without type or data", lineNumber)
+		}
+
+		subDomain, ok := relativeName(name, fqdn(zone))
+		if !ok {
+			continue
+		}
+		records = append(records, &Record{
+			Zone:      zone,
+			FieldType: strings.ToUpper(fields[0]),
+			SubDomain: subDomain,
+			Target:    strings.Join(fields[1:], " "),
+			TTL:       ttl,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("Invalid zone file: unclosed parenthesis")
+	}
+	return records, nil
+}
+
+// FormatZone writes records of zone in the BIND zone file format, as read by
+// Import. Records without TTL use the default TTL of the zone.
+func FormatZone(w io.Writer, zone string, records []*Record) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "$ORIGIN %s\n", fqdn(zone))
+	for _, record := range records {
+		name := record.SubDomain
+		if name == "" {
+			name = "@"
+		}
+		if record.TTL != 0 {
+			fmt.Fprintf(bw, "%s\t%d\tIN %s\t%s\n", name, record.TTL, record.FieldType, record.Target)
+		} else {
+			fmt.Fprintf(bw, "%s\tIN %s\t%s\n", name, record.FieldType, record.Target)
+		}
+	}
+	return bw.Flush()
+}
+
+// ttlUnits are the units of BIND TTLs, in seconds.
+var ttlUnits = map[rune]int64{'w': 7 * 86400, 'd': 86400, 'h': 3600, 'm': 60, 's': 1}
+
+// parseTTL parses a TTL in seconds, or in the BIND format with units, such
+// as 1h30m or 2D.
+func parseTTL(s string) (int64, bool) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, n >= 0
+	}
+
+	var ttl, n int64
+	digits := false
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+			digits = true
+			continue
+		}
+		unit, ok := ttlUnits[c]
+		if !ok || !digits {
+			return 0, false
+		}
+		ttl += n * unit
+		n, digits = 0, false
+	}
+	return ttl, !digits
+}
+
+// stripComment removes the comment of a line, and returns the change in
+// parenthesis depth, outside of quoted strings. Parentheses are replaced by
+// spaces.
+func stripComment(line string) (string, int) {
+	var b strings.Builder
+	depth, quoted, escaped := 0, false, false
+	for _, c := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == ';':
+			return b.String(), depth
+		case c == '(':
+			depth++
+			c = ' '
+		case c == ')':
+			depth--
+			c = ' '
+		}
+		b.WriteRune(c)
+	}
+	return b.String(), depth
+}
+
+// splitFields splits a line on blanks, keeping quoted strings whole.
+func splitFields(line string) []string {
+	var fields []string
+	var b strings.Builder
+	quoted, escaped, inField := false, false, false
+	for _, c := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case !quoted && (c == ' ' || c == '\t'):
+			if inField {
+				fields = append(fields, b.String())
+				b.Reset()
+				inField = false
+			}
+			continue
+		}
+		b.WriteRune(c)
+		inField = true
+	}
+	if inField {
+		fields = append(fields, b.String())
+	}
+	return fields
+}
+
+// fqdn returns name with a trailing dot.
+func fqdn(name string) string {
+	return strings.TrimSuffix(name, ".") + "."
+}
+
+// absoluteName returns a name of a zone file as a fully qualified name.
+func absoluteName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	}
+	return name + "." + origin
+}
+
+// relativeName returns a fully qualified name relative to the zone, and
+// whether it is in the zone.
+func relativeName(name, zone string) (string, bool) {
+	name, zone = strings.ToLower(name), strings.ToLower(zone)
+	if name == zone {
+		return "", true
+	}
+	if strings.HasSuffix(name, "."+zone) {
+		return strings.TrimSuffix(name, "."+zone), true
+	}
+	return "", false
+}
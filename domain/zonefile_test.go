@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+const testZoneFile = `$TTL 3600
+@	IN SOA dns200.anycast.me. tech.ovh.net. (
+		2024030100 ; serial
+		86400 3600 3600000 300 )
+	IN NS	dns200.anycast.me.
+@	IN NS	ns200.anycast.me.
+www	60 IN A	192.0.2.1
+	IN AAAA	2001:db8::1
+mail.example.com.	IN MX	10 mx1.example.net.
+@	IN TXT	"v=spf1 include:mx.ovh.com ~all; comment in quotes"
+other.example.net.	IN A	192.0.2.9 ; outside of the zone
+$ORIGIN sub.example.com.
+api	IN CNAME	www.example.com.
+`
+
+func TestParseZone(t *testing.T) {
+	records, err := ParseZone(strings.NewReader(testZoneFile), "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []*Record{
+		{Zone: "example.com", FieldType: "SOA", SubDomain: "", Target: "dns200.anycast.me. tech.ovh.net. 2024030100 86400 3600 3600000 300", TTL: 3600},
+		{Zone: "example.com", FieldType: "NS", SubDomain: "", Target: "dns200.anycast.me.", TTL: 3600},
+		{Zone: "example.com", FieldType: "NS", SubDomain: "", Target: "ns200.anycast.me.", TTL: 3600},
+		{Zone: "example.com", FieldType: "A", SubDomain: "www", Target: "192.0.2.1", TTL: 60},
+		{Zone: "example.com", FieldType: "AAAA", SubDomain: "www", Target: "2001:db8::1", TTL: 3600},
+		{Zone: "example.com", FieldType: "MX", SubDomain: "mail", Target: "10 mx1.example.net.", TTL: 3600},
+		{Zone: "example.com", FieldType: "TXT", SubDomain: "", Target: `"v=spf1 include:mx.ovh.com ~all; comment in quotes"`, TTL: 3600},
+		{Zone: "example.com", FieldType: "CNAME", SubDomain: "api.sub", Target: "www.example.com.", TTL: 3600},
+	}
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records, got %d", len(expected), len(records))
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(records[i], expected[i]) {
+			t.Fatalf("record %d: expected %+v, got %+v", i, expected[i], records[i])
+		}
+	}
+
+	var out bytes.Buffer
+	if err := FormatZone(&out, "example.com", records[3:5]); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "$ORIGIN example.com.\nwww\t60\tIN A\t192.0.2.1\nwww\t3600\tIN AAAA\t2001:db8::1\n" {
+		t.Fatalf("unexpected zone file %q", out.String())
+	}
+
+	reparsed, err := ParseZone(&out, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(reparsed, records[3:5]) {
+		t.Fatalf("unexpected records after a round trip %+v", reparsed)
+	}
+
+	records, err = ParseZone(strings.NewReader("$TTL 1h\nwww 1h30m IN A 192.0.2.1\nmail IN A 192.0.2.2\n"), "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].FieldType != "A" || records[0].TTL != 5400 || records[1].TTL != 3600 {
+		t.Fatalf("unexpected records with TTL units %+v %+v", records[0], records[1])
+	}
+	if _, err := ParseZone(strings.NewReader("www 1x IN A 192.0.2.1\n"), "example.com"); err == nil || !strings.Contains(err.Error(), "line 1: invalid TTL") {
+		t.Fatalf("expected an error for an invalid TTL, got %v", err)
+	}
+	if _, err := ParseZone(strings.NewReader("$TTL 1h2\n"), "example.com"); err == nil {
+		t.Fatal("expected an error for a TTL without unit after a unit")
+	}
+
+	if _, err := ParseZone(strings.NewReader("@ IN SOA a. b. ( 1 2\n"), "example.com"); err == nil {
+		t.Fatal("expected an error for an unclosed parenthesis")
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.HandleFunc("GET /domain/zone/example.com/export", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte(testZoneFile))
+	})
+	server.Handle("GET /domain/zone/example.org/export", 200, "$TTL 3600\n")
+	server.Handle("POST /domain/zone/example.com/import", 200, map[string]interface{}{"id": 77, "function": "ImportZone", "status": "todo"})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	text, err := client.Export(ctx, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != testZoneFile {
+		t.Fatalf("unexpected plain text export %q", text)
+	}
+
+	text, err = client.Export(ctx, "example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "$TTL 3600\n" {
+		t.Fatalf("unexpected JSON export %q", text)
+	}
+
+	task, err := client.Import(ctx, "example.com", "$TTL 60\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); task.ID != 77 || body != `{"zoneFile":"$TTL 60\n"}` {
+		t.Fatalf("unexpected task %+v for %s", task, body)
+	}
+}
@@ -0,0 +1,59 @@
+package govh
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+)
+
+// ErrDryRun is returned for calls intercepted by a dry-run caller, when
+// DryRunError is set.
+var ErrDryRun = errors.New("govh: call not performed in dry-run mode")
+
+// isSafeMethod tells whether method doesn't modify resources.
+func isSafeMethod(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS":
+		return true
+	}
+	return false
+}
+
+// dryRun logs the signed request of an intercepted call, and returns a
+// synthetic empty success response, or ErrDryRun.
+func (caller *Caller) dryRun(ctx context.Context, options *callOptions, method, completeURL string, params []byte) (*http.Response, error) {
+	request, err := caller.newRequest(ctx, options, method, completeURL, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The request is signed but never sent: its signature could be
+	// replayed, and is redacted like the secrets of the body.
+	if caller.Logger != nil {
+		signature := request.Header.Get("X-Ovh-Signature")
+		if signature != "" {
+			signature = redacted
+		}
+		caller.Logger.Printf("govh: dry-run: %s %s timestamp=%s signature=%s body=%s",
+			method, completeURL,
+			request.Header.Get("X-Ovh-Timestamp"), signature,
+			caller.redactBody(params))
+	}
+
+	if caller.DryRunError {
+		return nil, ErrDryRun
+	}
+
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		Request:    request,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package govh
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDryRun(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	c := &Caller{URL: server.URL, DryRun: true, Logger: log.New(&logs, "", 0)}
+
+	if err := c.CallAPI("/domain/zone/example.com/record", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CallAPI("/domain/zone/example.com/record", "POST", map[string]string{"fieldType": "A"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(methods) != 1 || methods[0] != "GET" {
+		t.Fatalf("only GET should reach the API, got %v", methods)
+	}
+	if !strings.Contains(logs.String(), `dry-run: POST `+server.URL+`/domain/zone/example.com/record`) ||
+		!strings.Contains(logs.String(), `body={"fieldType":"A"}`) {
+		t.Fatalf("intercepted call was not logged: %q", logs.String())
+	}
+
+	c.DryRunError = true
+	if err := c.CallAPI("/domain/zone/example.com/record/1", "DELETE", nil, nil); !errors.Is(err, ErrDryRun) {
+		t.Fatalf("expected ErrDryRun, got %v", err)
+	}
+}
+
+func TestDryRunRedaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			w.Write([]byte(strconv.FormatInt(time.Now().Unix(), 10)))
+			return
+		}
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	c := &Caller{URL: server.URL, ApplicationKey: "ak", ApplicationSecret: "secret-as", ConsumerKey: "secret-ck", DryRun: true, Logger: log.New(&logs, "", 0)}
+
+	if err := c.CallAPI("/me/api/credential", "POST", map[string]string{"consumerKey": "secret-ck"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(logs.String(), "signature=REDACTED") || !strings.Contains(logs.String(), `body={"consumerKey":"REDACTED"}`) {
+		t.Fatalf("secrets were not redacted: %q", logs.String())
+	}
+	if strings.Contains(logs.String(), "$1$") || strings.Contains(logs.String(), "secret-ck") {
+		t.Fatalf("secrets were logged: %q", logs.String())
+	}
+}
@@ -0,0 +1,59 @@
+package govh
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// API URLs
+// Use RegisterEndpoint to add an endpoint once callers may be in use.
+var APIURL = map[string]string{
+	"ovh-eu":        "https://api.ovh.com/1.0",
+	"ovh-ca":        "https://ca.api.ovh.com/1.0",
+	"kimsufi-eu":    "https://eu.api.kimsufi.com/1.0",
+	"kimsufi-ca":    "https://ca.api.kimsufi.com/1.0",
+	"soyoustart-eu": "https://eu.api.soyoustart.com/1.0",
+	"soyoustart-ca": "https://ca.api.soyoustart.com/1.0",
+	"runabove":      "https://api.runabove.com/1.0",
+}
+
+// endpointsMu protects APIURL.
+var endpointsMu sync.RWMutex
+
+// RegisterEndpoint adds or replaces an endpoint in APIURL, so that it can be
+// given to NewCaller by name.
+func RegisterEndpoint(name, endpointURL string) error {
+	if name == "" {
+		return fmt.Errorf("Endpoint name can't be empty")
+	}
+
+	u, err := url.Parse(endpointURL)
+	if err != nil {
+		return fmt.Errorf("Invalid URL for endpoint %q: %s", name, err)
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("Invalid URL for endpoint %q: %q", name, endpointURL)
+	}
+
+	endpointsMu.Lock()
+	defer endpointsMu.Unlock()
+
+	APIURL[name] = strings.TrimRight(endpointURL, "/")
+	return nil
+}
+
+// endpointURL resolves an endpoint name to its base URL. Full URLs are
+// accepted as is, to target gateways not listed in APIURL.
+func endpointURL(endpoint string) (string, bool) {
+	if strings.HasPrefix(endpoint, "https://") || strings.HasPrefix(endpoint, "http://") {
+		return strings.TrimRight(endpoint, "/"), true
+	}
+
+	endpointsMu.RLock()
+	defer endpointsMu.RUnlock()
+
+	url, ok := APIURL[endpoint]
+	return url, ok
+}
@@ -0,0 +1,24 @@
+package govh
+
+import "testing"
+
+func TestRegisterEndpoint(t *testing.T) {
+	if err := RegisterEndpoint("gateway", "https://ovh-gateway.example.com/1.0/"); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		endpointsMu.Lock()
+		delete(APIURL, "gateway")
+		endpointsMu.Unlock()
+	}()
+
+	if url, ok := endpointURL("gateway"); !ok || url != "https://ovh-gateway.example.com/1.0" {
+		t.Fatalf("unexpected endpoint URL %q", url)
+	}
+
+	for _, invalid := range []string{"", "ovh-gateway.example.com", "ftp://example.com"} {
+		if err := RegisterEndpoint("invalid", invalid); err == nil {
+			t.Errorf("expected an error for URL %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package govh
+
+import (
+	"fmt"
+	"os"
+)
+
+// Environment variables read by NewCallerFromEnv.
+const (
+	EnvEndpoint          = "OVH_ENDPOINT"
+	EnvApplicationKey    = "OVH_APPLICATION_KEY"
+	EnvApplicationSecret = "OVH_APPLICATION_SECRET"
+	EnvConsumerKey       = "OVH_CONSUMER_KEY"
+	EnvClientID          = "OVH_CLIENT_ID"
+	EnvClientSecret      = "OVH_CLIENT_SECRET"
+)
+
+// LoadEnvConfig reads the configuration from environment variables.
+// OVH_ENDPOINT is required, along with either OVH_APPLICATION_KEY and
+// OVH_APPLICATION_SECRET, or OVH_CLIENT_ID and OVH_CLIENT_SECRET for OAuth2.
+// OVH_CONSUMER_KEY is optional.
+func LoadEnvConfig() (*Config, error) {
+	config := &Config{
+		Endpoint:          os.Getenv(EnvEndpoint),
+		ApplicationKey:    os.Getenv(EnvApplicationKey),
+		ApplicationSecret: os.Getenv(EnvApplicationSecret),
+		ConsumerKey:       os.Getenv(EnvConsumerKey),
+		ClientID:          os.Getenv(EnvClientID),
+		ClientSecret:      os.Getenv(EnvClientSecret),
+	}
+
+	required := map[string]string{EnvEndpoint: config.Endpoint}
+	order := []string{EnvEndpoint}
+	if config.ClientID != "" || config.ClientSecret != "" {
+		required[EnvClientID] = config.ClientID
+		required[EnvClientSecret] = config.ClientSecret
+		order = append(order, EnvClientID, EnvClientSecret)
+	} else {
+		required[EnvApplicationKey] = config.ApplicationKey
+		required[EnvApplicationSecret] = config.ApplicationSecret
+		order = append(order, EnvApplicationKey, EnvApplicationSecret)
+	}
+
+	for _, name := range order {
+		if required[name] == "" {
+			return nil, fmt.Errorf("Environment variable %s is not set", name)
+		}
+	}
+
+	return config, nil
+}
+
+// NewCallerFromEnv creates a caller from environment variables.
+// See LoadEnvConfig.
+func NewCallerFromEnv() (*Caller, error) {
+	config, err := LoadEnvConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(config.Endpoint, config.Options()...)
+}
@@ -0,0 +1,34 @@
+package govh
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLoadEnvConfig(t *testing.T) {
+	for _, name := range []string{EnvEndpoint, EnvApplicationKey, EnvApplicationSecret, EnvConsumerKey, EnvClientID, EnvClientSecret} {
+		if value, ok := os.LookupEnv(name); ok {
+			defer os.Setenv(name, value)
+		} else {
+			defer os.Unsetenv(name)
+		}
+		os.Unsetenv(name)
+	}
+
+	os.Setenv(EnvEndpoint, "ovh-eu")
+	os.Setenv(EnvApplicationKey, "ak")
+
+	if _, err := LoadEnvConfig(); err == nil || !strings.Contains(err.Error(), EnvApplicationSecret) {
+		t.Fatalf("expected an error naming %s, got %v", EnvApplicationSecret, err)
+	}
+
+	os.Setenv(EnvApplicationSecret, "as")
+	config, err := LoadEnvConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *config != (Config{Endpoint: "ovh-eu", ApplicationKey: "ak", ApplicationSecret: "as"}) {
+		t.Fatalf("unexpected config %+v", config)
+	}
+}
@@ -1,17 +1,158 @@
 package govh
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Errors matched by ApiOvhError with errors.Is, according to its HTTP code
+// or to the errorCode returned by the API:
+//
+//	if errors.Is(err, govh.ErrNotFound) {
+//		...
+//	}
+var (
+	ErrBadRequest   = errors.New("govh: bad request")
+	ErrUnauthorized = errors.New("govh: unauthorized")
+	ErrForbidden    = errors.New("govh: forbidden")
+	ErrNotFound     = errors.New("govh: not found")
+	ErrConflict     = errors.New("govh: conflict")
+	ErrRateLimited  = errors.New("govh: rate limited")
+	ErrServer       = errors.New("govh: server error")
+
+	// The signature or the timestamp of the request was rejected.
+	ErrInvalidSignature = errors.New("govh: invalid signature")
+	// The application key is unknown.
+	ErrInvalidKey = errors.New("govh: invalid application key")
+	// The consumer key is unknown, not validated or expired.
+	ErrInvalidCredential = errors.New("govh: invalid credential")
+	// The consumer key doesn't allow the call.
+	ErrNotGranted = errors.New("govh: call not granted")
+)
+
+// statusErrors maps HTTP codes to the errors they match.
+var statusErrors = map[int]error{
+	http.StatusBadRequest:      ErrBadRequest,
+	http.StatusUnauthorized:    ErrUnauthorized,
+	http.StatusForbidden:       ErrForbidden,
+	http.StatusNotFound:        ErrNotFound,
+	http.StatusConflict:        ErrConflict,
+	http.StatusTooManyRequests: ErrRateLimited,
+}
+
+// errorCodeErrors maps the errorCode values of the API to the errors they
+// match.
+var errorCodeErrors = map[string]error{
+	"INVALID_SIGNATURE":  ErrInvalidSignature,
+	"QUERY_TIME_OUT":     ErrInvalidSignature,
+	"BAD_TIMESTAMP":      ErrInvalidSignature,
+	"INVALID_KEY":        ErrInvalidKey,
+	"INVALID_CREDENTIAL": ErrInvalidCredential,
+	"NOT_CREDENTIAL":     ErrInvalidCredential,
+	"NOT_GRANTED_CALL":   ErrNotGranted,
+}
 
 // ApiOvhError represents an error that can occured while calling the API.
 type ApiOvhError struct {
 	// Error message.
-	Message string
+	Message string `json:"message"`
 	// HTTP code.
-	Code int
-	// Unique request tracer.
-	Tracer string
+	Code int `json:"-"`
+	// HTTP status line, such as "403 Forbidden".
+	HTTPCode string `json:"httpCode"`
+	// Class of the error, such as "Client::NotFound".
+	Class string `json:"class"`
+	// Error code of the API, such as "INVALID_CREDENTIAL".
+	ErrorCode string `json:"errorCode"`
+	// Additional details given by the API, if any.
+	Details map[string]interface{} `json:"details"`
+	// Unique request tracer, given by X-Ovh-QueryID header. It should be
+	// given to OVH support when reporting an issue.
+	Tracer string `json:"-"`
+	// Delay before trying again, given by the Retry-After header of 429 and
+	// 503 responses. Zero when not given.
+	RetryAfter time.Duration `json:"-"`
 }
 
 func (err *ApiOvhError) Error() string {
+	if err.Tracer != "" {
+		return fmt.Sprintf("Error %d : %q (QueryID: %s)", err.Code, err.Message, err.Tracer)
+	}
 	return fmt.Sprintf("Error %d : %q", err.Code, err.Message)
 }
+
+// Is makes ApiOvhError match the Err* errors of its HTTP code and error code.
+func (err *ApiOvhError) Is(target error) bool {
+	if target == statusErrors[err.Code] {
+		return target != nil
+	}
+	if target == ErrServer {
+		return err.Code >= 500
+	}
+	sentinel, ok := errorCodeErrors[err.ErrorCode]
+	return ok && target == sentinel
+}
+
+// IsNotFound tells whether err is an API error for a missing resource.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsForbidden tells whether err is an API error for a forbidden call.
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsRateLimited tells whether err is an API error for a rate-limited call.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsInvalidCredential tells whether err is an API error for an unknown, not
+// validated or expired consumer key.
+func IsInvalidCredential(err error) bool {
+	return errors.Is(err, ErrInvalidCredential)
+}
+
+// apiErrorFromResponse builds the error returned for an unsuccessful
+// response.
+func apiErrorFromResponse(result *http.Response, body []byte) *ApiOvhError {
+	apiError := &ApiOvhError{
+		Code:       result.StatusCode,
+		Tracer:     result.Header.Get("X-Ovh-QueryID"),
+		RetryAfter: retryAfter(result.Header, time.Now()),
+	}
+	if err := json.Unmarshal(body, apiError); err != nil {
+		// Not an API error, such as an HTML page of the load balancer
+		apiError = &ApiOvhError{
+			Code:       result.StatusCode,
+			HTTPCode:   result.Status,
+			Message:    rawErrorMessage(result.StatusCode, body),
+			Tracer:     apiError.Tracer,
+			RetryAfter: apiError.RetryAfter,
+		}
+	}
+
+	return apiError
+}
+
+// maxRawErrorLength is the length above which non-JSON error bodies are
+// truncated.
+const maxRawErrorLength = 512
+
+// rawErrorMessage returns the message of an error whose body couldn't be
+// decoded.
+func rawErrorMessage(statusCode int, body []byte) string {
+	message := strings.TrimSpace(string(body))
+	if message == "" {
+		return http.StatusText(statusCode)
+	}
+	if len(message) > maxRawErrorLength {
+		message = strings.ToValidUTF8(message[:maxRawErrorLength], "") + "..."
+	}
+	return message
+}
@@ -0,0 +1,77 @@
+package govh
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestApiOvhErrorIs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"class":"Client::NotFound","message":"The requested object does not exist"}`))
+		case "/credential":
+			w.Header().Set("X-Ovh-QueryID", "EU.ext-1.abc")
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errorCode":"INVALID_CREDENTIAL","httpCode":"403 Forbidden","message":"This credential is not valid"}`))
+		case "/gateway":
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("<html><body><h1>502 Bad Gateway</h1>" + strings.Repeat(" ", 1000) + "</body></html>"))
+		case "/empty":
+			w.WriteHeader(http.StatusGatewayTimeout)
+		case "/down":
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"message":"Service unavailable"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+
+	err := c.Get("/missing", nil)
+	if !IsNotFound(err) || errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if err.(*ApiOvhError).Class != "Client::NotFound" {
+		t.Fatalf("unexpected class %q", err.(*ApiOvhError).Class)
+	}
+
+	err = c.Get("/credential", nil)
+	if !IsForbidden(err) || !IsInvalidCredential(err) || errors.Is(err, ErrNotGranted) {
+		t.Fatalf("expected an invalid credential error, got %v", err)
+	}
+	var apiError *ApiOvhError
+	if !errors.As(fmt.Errorf("listing zones: %w", err), &apiError) || apiError.ErrorCode != "INVALID_CREDENTIAL" {
+		t.Fatalf("unexpected error %+v", apiError)
+	}
+	if apiError.HTTPCode != "403 Forbidden" || apiError.Tracer != "EU.ext-1.abc" {
+		t.Fatalf("unexpected error details %+v", apiError)
+	}
+	if expected := `Error 403 : "This credential is not valid" (QueryID: EU.ext-1.abc)`; err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err.Error())
+	}
+
+	err = c.Get("/gateway", nil)
+	if !errors.As(err, &apiError) || apiError.Code != http.StatusBadGateway || !errors.Is(err, ErrServer) {
+		t.Fatalf("expected a bad gateway error, got %v", err)
+	}
+	if !strings.HasPrefix(apiError.Message, "<html><body><h1>502 Bad Gateway</h1>") || len(apiError.Message) != maxRawErrorLength+3 {
+		t.Fatalf("unexpected message %q", apiError.Message)
+	}
+
+	err = c.Get("/empty", nil)
+	if !errors.As(err, &apiError) || apiError.Message != "Gateway Timeout" {
+		t.Fatalf("expected a gateway timeout error, got %v", err)
+	}
+
+	err = c.Get("/down", nil)
+	if !errors.Is(err, ErrServer) || IsNotFound(err) {
+		t.Fatalf("expected a server error, got %v", err)
+	}
+}
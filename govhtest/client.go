@@ -0,0 +1,107 @@
+package govhtest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Client is a govh.Client calling a function instead of the API, for unit
+// tests which don't need a server:
+//
+//	client := &govhtest.Client{Func: func(ctx context.Context, method, path string, body interface{}) (interface{}, error) {
+//		return map[string]string{"nichandle": "xx1234-ovh"}, nil
+//	}}
+//
+// The value returned by Func is encoded to JSON and decoded into the result
+// of the call, as a response of the API would be. Calls are recorded.
+type Client struct {
+	Func func(ctx context.Context, method, path string, body interface{}) (interface{}, error)
+	// Calls received, in order.
+	Calls []Call
+}
+
+// Call is a call received by a Client.
+type Call struct {
+	Method string
+	Path   string
+	Body   interface{}
+}
+
+var _ govh.Client = (*Client)(nil)
+
+// CallAPI implements govh.Client.
+func (client *Client) CallAPI(url, method string, body, typeResult interface{}) error {
+	return client.CallAPIWithContext(context.Background(), url, method, body, typeResult)
+}
+
+// CallAPIWithContext implements govh.Client. Call options are ignored.
+func (client *Client) CallAPIWithContext(ctx context.Context, url, method string, body, typeResult interface{}, opts ...govh.CallOption) error {
+	_, err := client.CallAPIRaw(ctx, url, method, body, typeResult, opts...)
+	return err
+}
+
+// CallAPIRaw implements govh.Client. The response has the JSON encoding of
+// the value returned by Func as body, and a 200 status.
+func (client *Client) CallAPIRaw(ctx context.Context, url, method string, body, typeResult interface{}, opts ...govh.CallOption) (*govh.Response, error) {
+	client.Calls = append(client.Calls, Call{Method: method, Path: url, Body: body})
+	if client.Func == nil {
+		return &govh.Response{StatusCode: http.StatusOK, Header: http.Header{}}, nil
+	}
+
+	value, err := client.Func(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	response := &govh.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: data}
+	if typeResult == nil {
+		return response, nil
+	}
+	return response, json.Unmarshal(data, typeResult)
+}
+
+// Get implements govh.Client.
+func (client *Client) Get(path string, result interface{}) error {
+	return client.CallAPI(path, "GET", nil, result)
+}
+
+// GetWithContext implements govh.Client.
+func (client *Client) GetWithContext(ctx context.Context, path string, result interface{}, opts ...govh.CallOption) error {
+	return client.CallAPIWithContext(ctx, path, "GET", nil, result, opts...)
+}
+
+// Post implements govh.Client.
+func (client *Client) Post(path string, body, result interface{}) error {
+	return client.CallAPI(path, "POST", body, result)
+}
+
+// PostWithContext implements govh.Client.
+func (client *Client) PostWithContext(ctx context.Context, path string, body, result interface{}, opts ...govh.CallOption) error {
+	return client.CallAPIWithContext(ctx, path, "POST", body, result, opts...)
+}
+
+// Put implements govh.Client.
+func (client *Client) Put(path string, body, result interface{}) error {
+	return client.CallAPI(path, "PUT", body, result)
+}
+
+// PutWithContext implements govh.Client.
+func (client *Client) PutWithContext(ctx context.Context, path string, body, result interface{}, opts ...govh.CallOption) error {
+	return client.CallAPIWithContext(ctx, path, "PUT", body, result, opts...)
+}
+
+// Delete implements govh.Client.
+func (client *Client) Delete(path string, result interface{}) error {
+	return client.CallAPI(path, "DELETE", nil, result)
+}
+
+// DeleteWithContext implements govh.Client.
+func (client *Client) DeleteWithContext(ctx context.Context, path string, result interface{}, opts ...govh.CallOption) error {
+	return client.CallAPIWithContext(ctx, path, "DELETE", nil, result, opts...)
+}
@@ -0,0 +1,107 @@
+package govhtest
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// Fixture returns the JSON fixture with the given name, as returned by the
+// API. Available fixtures are:
+//
+//	"me"                GET /me
+//	"domain"            GET /domain/{serviceName}
+//	"domain_zone"       GET /domain/zone/{zoneName}
+//	"dedicated_server"  GET /dedicated/server/{serviceName}
+//	"cloud_instance"    GET /cloud/project/{serviceName}/instance/{instanceId}, decoded by CloudInstance
+//
+// The other fixtures are decoded by the types of the me, domain and dedicated
+// packages, whose tests check them with DecodeFixture.
+//
+// It panics if the fixture doesn't exist.
+func Fixture(name string) json.RawMessage {
+	data, err := fixtures.ReadFile("fixtures/" + name + ".json")
+	if err != nil {
+		panic(fmt.Sprintf("govhtest: unknown fixture %q", name))
+	}
+	return json.RawMessage(data)
+}
+
+// DecodeFixture decodes the fixture with the given name into v, failing the
+// test if the fixture has fields that v doesn't know about, so that fixtures
+// stay in sync with the types decoding them.
+func DecodeFixture(t testing.TB, name string, v interface{}) {
+	t.Helper()
+
+	decoder := json.NewDecoder(bytes.NewReader(Fixture(name)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		t.Fatalf("fixture %s doesn't match %T: %s", name, v, err)
+	}
+}
+
+// HandleFixtures serves the fixtures, and the lists of their identifiers:
+//
+//	GET /me
+//	GET /domain
+//	GET /domain/{serviceName}
+//	GET /domain/zone
+//	GET /domain/zone/{zoneName}
+//	GET /dedicated/server
+//	GET /dedicated/server/{serviceName}
+//	GET /cloud/project/{serviceName}/instance
+//	GET /cloud/project/{serviceName}/instance/{instanceId}
+//
+// Fixtures are served for any identifier. Routes registered later take
+// precedence, to override some of them.
+func (server *Server) HandleFixtures() {
+	server.Handle("GET /me", http.StatusOK, Fixture("me"))
+	server.Handle("GET /domain", http.StatusOK, []string{"example.com"})
+	server.Handle("GET /domain/{serviceName}", http.StatusOK, Fixture("domain"))
+	server.Handle("GET /domain/zone", http.StatusOK, []string{"example.com"})
+	server.Handle("GET /domain/zone/{zoneName}", http.StatusOK, Fixture("domain_zone"))
+	server.Handle("GET /dedicated/server", http.StatusOK, []string{"ns1234567.ip-192-0-2.eu"})
+	server.Handle("GET /dedicated/server/{serviceName}", http.StatusOK, Fixture("dedicated_server"))
+	server.Handle("GET /cloud/project/{serviceName}/instance", http.StatusOK, []json.RawMessage{Fixture("cloud_instance")})
+	server.Handle("GET /cloud/project/{serviceName}/instance/{instanceId}", http.StatusOK, Fixture("cloud_instance"))
+}
+
+// CloudInstance is a Public Cloud instance returned by
+// GET /cloud/project/{serviceName}/instance/{instanceId}.
+type CloudInstance struct {
+	ID                          string           `json:"id"`
+	Name                        string           `json:"name"`
+	Status                      string           `json:"status"`
+	Region                      string           `json:"region"`
+	FlavorID                    string           `json:"flavorId"`
+	ImageID                     string           `json:"imageId"`
+	SSHKeyID                    string           `json:"sshKeyId"`
+	PlanCode                    string           `json:"planCode"`
+	MonthlyBilling              *MonthlyBilling  `json:"monthlyBilling"`
+	IPAddresses                 []CloudIPAddress `json:"ipAddresses"`
+	OperationIDs                []string         `json:"operationIds"`
+	CurrentMonthOutgoingTraffic *int64           `json:"currentMonthOutgoingTraffic"`
+	Created                     time.Time        `json:"created"`
+}
+
+// MonthlyBilling is the monthly billing of a cloud instance, if enabled.
+type MonthlyBilling struct {
+	Since  time.Time `json:"since"`
+	Status string    `json:"status"`
+}
+
+// CloudIPAddress is an IP address of a cloud instance.
+type CloudIPAddress struct {
+	IP        string `json:"ip"`
+	Type      string `json:"type"`
+	Version   int    `json:"version"`
+	NetworkID string `json:"networkId"`
+	GatewayIP string `json:"gatewayIp"`
+}
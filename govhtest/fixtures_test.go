@@ -0,0 +1,59 @@
+package govhtest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFixtures(t *testing.T) {
+	for _, name := range []string{"me", "domain", "domain_zone", "dedicated_server"} {
+		var value map[string]interface{}
+		if err := json.Unmarshal(Fixture(name), &value); err != nil {
+			t.Errorf("fixture %s: %s", name, err)
+		}
+	}
+	DecodeFixture(t, "cloud_instance", &CloudInstance{})
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for an unknown fixture")
+		}
+	}()
+	Fixture("unknown")
+}
+
+func TestHandleFixtures(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.HandleFixtures()
+	caller := server.Caller()
+
+	var zones []string
+	if err := caller.Get("/domain/zone", &zones); err != nil {
+		t.Fatal(err)
+	}
+	var zone map[string]interface{}
+	if err := caller.Get("/domain/zone/"+zones[0], &zone); err != nil {
+		t.Fatal(err)
+	}
+	if zone["name"] != "example.com" {
+		t.Fatalf("unexpected zone %+v", zone)
+	}
+
+	var instances []CloudInstance
+	if err := caller.Get("/cloud/project/abc/instance", &instances); err != nil {
+		t.Fatal(err)
+	}
+	if len(instances) != 1 || len(instances[0].IPAddresses) != 2 {
+		t.Fatalf("unexpected instances %+v", instances)
+	}
+
+	server.Handle("GET /dedicated/server/{serviceName}", 200, map[string]string{"name": "other", "state": "error"})
+	var dedicated map[string]interface{}
+	if err := caller.Get("/dedicated/server/other", &dedicated); err != nil {
+		t.Fatal(err)
+	}
+	if dedicated["state"] != "error" {
+		t.Fatalf("expected the fixture to be overridden, got %+v", dedicated)
+	}
+}
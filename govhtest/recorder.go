@@ -0,0 +1,210 @@
+package govhtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// RecorderMode tells whether a Recorder records or replays interactions.
+type RecorderMode int
+
+const (
+	// ModeReplay serves recorded responses, without reaching the API.
+	ModeReplay RecorderMode = iota
+	// ModeRecord performs requests and records the interactions.
+	ModeRecord
+)
+
+// Interaction is a request and its response, as stored in a cassette.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request stored in a cassette. Its headers are not
+// stored, as they hold credentials.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	// Path and query of the request URL.
+	URL  string `json:"url"`
+	Body string `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response stored in a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// Recorder is an http.RoundTripper recording interactions with the API to a
+// cassette file, and replaying them, so that tests of code calling the real
+// API can run hermetically:
+//
+//	mode := govhtest.ModeReplay
+//	if os.Getenv("RECORD") != "" {
+//		mode = govhtest.ModeRecord
+//	}
+//	recorder, err := govhtest.NewRecorder("testdata/zones.json", mode)
+//	defer recorder.Save()
+//	caller, err := govh.NewClient("ovh-eu", govh.WithHTTPClient(&http.Client{Transport: recorder}), ...)
+//
+// Request headers are not recorded. Recorded bodies are redacted like the
+// logs of a Caller: the values of secret JSON fields, such as consumerKey or
+// password, the consumer key or token authenticating the request, and Secrets
+// are removed. Requests are matched on their method, URL path and query, and body;
+// identical requests are replayed in order.
+type Recorder struct {
+	// Cassette file.
+	Path string
+	Mode RecorderMode
+	// Transport performing requests in record mode, http.DefaultTransport if
+	// nil.
+	Transport http.RoundTripper
+	// Other strings replaced by "REDACTED" in recorded bodies, such as the
+	// application secret.
+	Secrets []string
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     []bool
+}
+
+// NewRecorder creates a recorder for the cassette at path. In replay mode,
+// the cassette is loaded.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	recorder := &Recorder{Path: path, Mode: mode}
+	if mode != ModeReplay {
+		return recorder, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &recorder.interactions); err != nil {
+		return nil, fmt.Errorf("Invalid cassette %s: %s", path, err)
+	}
+	recorder.replayed = make([]bool, len(recorder.interactions))
+	return recorder, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (recorder *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	recorded := RecordedRequest{
+		Method: request.Method,
+		URL:    request.URL.RequestURI(),
+		Body:   recorder.redact(request, string(body)),
+	}
+
+	if recorder.Mode == ModeReplay {
+		return recorder.replay(request, recorded)
+	}
+	return recorder.record(request, body, recorded)
+}
+
+func (recorder *Recorder) replay(request *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	for i, interaction := range recorder.interactions {
+		if recorder.replayed[i] || interaction.Request != recorded {
+			continue
+		}
+		recorder.replayed[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       request,
+		}, nil
+	}
+	return nil, fmt.Errorf("govhtest: no recorded interaction for %s %s in %s", recorded.Method, recorded.URL, recorder.Path)
+}
+
+func (recorder *Recorder) record(request *http.Request, body []byte, recorded RecordedRequest) (*http.Response, error) {
+	// Ask for an uncompressed response, to store it as is
+	request = request.Clone(request.Context())
+	request.Header.Del("Accept-Encoding")
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	transport := recorder.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	result, err := transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := ioutil.ReadAll(result.Body)
+	result.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	result.Body = ioutil.NopCloser(bytes.NewReader(resBody))
+
+	header := result.Header.Clone()
+	header.Del("Set-Cookie")
+	header.Del("Date")
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	recorder.interactions = append(recorder.interactions, &Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: result.StatusCode,
+			Header:     header,
+			Body:       recorder.redact(request, string(resBody)),
+		},
+	})
+	return result, nil
+}
+
+// Save writes the recorded interactions to the cassette file. It does
+// nothing in replay mode.
+func (recorder *Recorder) Save() error {
+	if recorder.Mode == ModeReplay {
+		return nil
+	}
+
+	recorder.mu.Lock()
+	data, err := json.MarshalIndent(recorder.interactions, "", "  ")
+	recorder.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(recorder.Path, append(data, '\n'), 0644)
+}
+
+// redact removes the secrets from a body recorded for request.
+func (recorder *Recorder) redact(request *http.Request, s string) string {
+	secrets := append([]string{
+		request.Header.Get("X-Ovh-Consumer"),
+		strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer "),
+	}, recorder.Secrets...)
+	return govh.RedactBody(s, secrets...)
+}
+
+var _ http.RoundTripper = (*Recorder)(nil)
@@ -0,0 +1,97 @@
+package govhtest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+func recorderCaller(t *testing.T, url string, recorder *Recorder) *govh.Caller {
+	caller, err := govh.NewClient(url,
+		govh.WithCredentials(ApplicationKey, ApplicationSecret, ConsumerKey),
+		govh.WithHTTPClient(&http.Client{Transport: recorder}),
+		govh.WithoutTimeSync(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return caller
+}
+
+func TestRecorder(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Handle("GET /me", http.StatusOK, map[string]string{"nichandle": "xx1234-ovh", "token": "s3cr3t"})
+	server.Handle("POST /domain/zone/{zoneName}/refresh", http.StatusOK, nil)
+	server.Handle("POST /me/api/credential", http.StatusOK, map[string]string{"consumerKey": "nEwCoNsUmErKeY", "echo": ConsumerKey})
+	server.HandleError("GET /me/bill/{billID}", http.StatusNotFound, "", "This bill does not exist")
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := NewRecorder(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder.Secrets = []string{"s3cr3t"}
+
+	run := func(caller *govh.Caller) {
+		var me struct{ Nichandle, Token string }
+		if err := caller.Get("/me", &me); err != nil {
+			t.Fatal(err)
+		}
+		if me.Nichandle != "xx1234-ovh" {
+			t.Fatalf("unexpected result %+v", me)
+		}
+		if err := caller.Post("/domain/zone/example.com/refresh", map[string]string{"a": "b"}, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := caller.Post("/me/api/credential", map[string]string{"password": "hunter2"}, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := caller.Get("/me/bill/FR42", nil); !govh.IsNotFound(err) {
+			t.Fatalf("expected a not found error, got %v", err)
+		}
+	}
+
+	run(recorderCaller(t, server.URL, recorder))
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"s3cr3t", ConsumerKey, ApplicationKey, "nEwCoNsUmErKeY", "hunter2"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("cassette contains secret %q:\n%s", secret, data)
+		}
+	}
+
+	// Replay against a closed server
+	server.Close()
+	count := len(server.Requests())
+
+	replayer, err := NewRecorder(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	run(recorderCaller(t, server.URL, replayer))
+	if len(server.Requests()) != count {
+		t.Fatal("expected no request to reach the server when replaying")
+	}
+
+	// Every interaction was replayed
+	if err := recorderCaller(t, server.URL, replayer).Get("/me", nil); err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+		t.Fatalf("expected a missing interaction error, got %v", err)
+	}
+}
+
+func TestRecorderMissingCassette(t *testing.T) {
+	if _, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay); err == nil {
+		t.Fatal("expected an error for a missing cassette")
+	}
+}
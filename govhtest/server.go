@@ -0,0 +1,265 @@
+// Package govhtest provides a fake OVH API server, to test code using govh
+// without network access nor real credentials:
+//
+//	server := govhtest.NewServer()
+//	defer server.Close()
+//	server.Handle("GET /me", http.StatusOK, map[string]string{"nichandle": "xx1234-ovh"})
+//
+//	caller := server.Caller()
+//	// ... code under test calling caller.Get("/me", &me)
+//
+// The server checks the signature of the requests made with the test
+// credentials, serves /auth/time, and records the requests it receives.
+// HandleFixtures serves fixtures of common resources.
+package govhtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Credentials accepted by the server.
+const (
+	ApplicationKey    = "govhtestApplicationKey"
+	ApplicationSecret = "govhtestApplicationSecret"
+	ConsumerKey       = "govhtestConsumerKey"
+)
+
+// MaxTimeSkew is the largest difference between the timestamp of a request
+// and the server clock accepted by the server.
+const MaxTimeSkew = 30 * time.Second
+
+// Request is a request received by the server.
+type Request struct {
+	Method string
+	// Path of the request, relative to the server URL.
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+	// Whether the request was signed, and its consumer key.
+	Signed      bool
+	ConsumerKey string
+}
+
+// Server is a fake OVH API server.
+type Server struct {
+	*httptest.Server
+
+	// Now returns the time of the server clock, time.Now by default.
+	Now func() time.Time
+
+	mu       sync.Mutex
+	routes   []*route
+	requests []*Request
+}
+
+// route is a handler registered for a pattern.
+type route struct {
+	method   string
+	segments []string
+	handler  http.HandlerFunc
+}
+
+// match tells whether the route matches r, and sets its path values.
+func (route *route) match(r *http.Request) bool {
+	if route.method != "" && route.method != r.Method {
+		return false
+	}
+
+	segments := strings.Split(r.URL.Path, "/")
+	if len(segments) != len(route.segments) {
+		return false
+	}
+	values := map[string]string{}
+	for i, segment := range route.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			values[segment[1:len(segment)-1]] = segments[i]
+		} else if segment != segments[i] {
+			return false
+		}
+	}
+
+	for name, value := range values {
+		r.SetPathValue(name, value)
+	}
+	return true
+}
+
+// NewServer starts a server without any route but /auth/time. The caller
+// should call Close when finished, to shut it down.
+func NewServer() *Server {
+	server := &Server{Now: time.Now}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+// Caller returns a caller authenticated with the test credentials, calling
+// the server. Time is synchronized on the first call.
+func (server *Server) Caller() *govh.Caller {
+	caller, err := govh.NewClient(server.URL,
+		govh.WithCredentials(ApplicationKey, ApplicationSecret, ConsumerKey),
+		govh.WithoutTimeSync(),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return caller
+}
+
+// Handle serves body as JSON with the given status for requests matching
+// pattern. A pattern is an optional method followed by a path relative to the
+// server URL, whose segments may be wildcards, such as
+// "GET /domain/zone/{zoneName}". Body may be a json.RawMessage to be served
+// as is.
+func (server *Server) Handle(pattern string, status int, body interface{}) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		panic(fmt.Sprintf("govhtest: can't encode body of %q: %s", pattern, err))
+	}
+
+	server.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write(data)
+	})
+}
+
+// HandleError answers requests matching pattern with an API error.
+func (server *Server) HandleError(pattern string, status int, errorCode, message string) {
+	server.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		WriteError(w, status, errorCode, message)
+	})
+}
+
+// HandleFunc registers the handler for requests matching pattern, see
+// Handle. Path wildcards are available with r.PathValue. Routes registered
+// later take precedence.
+func (server *Server) HandleFunc(pattern string, handler http.HandlerFunc) {
+	r := &route{handler: handler}
+	if i := strings.IndexByte(pattern, ' '); i >= 0 {
+		r.method, pattern = pattern[:i], strings.TrimSpace(pattern[i+1:])
+	}
+	r.segments = strings.Split(pattern, "/")
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.routes = append([]*route{r}, server.routes...)
+}
+
+// Requests returns the requests received by the server, except the ones to
+// /auth/time.
+func (server *Server) Requests() []*Request {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	return append([]*Request(nil), server.requests...)
+}
+
+// LastRequest returns the last request received by the server, or nil.
+func (server *Server) LastRequest() *Request {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if len(server.requests) == 0 {
+		return nil
+	}
+	return server.requests[len(server.requests)-1]
+}
+
+// WriteError writes an error as the API does.
+func WriteError(w http.ResponseWriter, status int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{
+		"errorCode": errorCode,
+		"httpCode":  fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		"message":   message,
+	})
+}
+
+func (server *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	now := server.Now()
+	if r.URL.Path == "/auth/time" {
+		fmt.Fprint(w, now.Unix())
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "", err.Error())
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	request := &Request{
+		Method:      r.Method,
+		Path:        r.URL.Path,
+		Query:       r.URL.Query(),
+		Header:      r.Header.Clone(),
+		Body:        body,
+		Signed:      r.Header.Get("X-Ovh-Signature") != "",
+		ConsumerKey: r.Header.Get("X-Ovh-Consumer"),
+	}
+	server.mu.Lock()
+	server.requests = append(server.requests, request)
+	routes := server.routes
+	server.mu.Unlock()
+
+	if status, errorCode, message := server.authenticate(r, now); status != 0 {
+		WriteError(w, status, errorCode, message)
+		return
+	}
+
+	for _, route := range routes {
+		if route.match(r) {
+			route.handler(w, r)
+			return
+		}
+	}
+	WriteError(w, http.StatusNotFound, "", "Got an invalid (or empty) URL")
+}
+
+// authenticate checks the authentication headers of a request, as the API
+// does. Requests without signature are not checked.
+func (server *Server) authenticate(r *http.Request, now time.Time) (int, string, string) {
+	application := r.Header.Get("X-Ovh-Application")
+	if application != "" && application != ApplicationKey {
+		return http.StatusForbidden, "INVALID_KEY", "Invalid application key"
+	}
+
+	signature := r.Header.Get("X-Ovh-Signature")
+	if signature == "" {
+		return 0, "", ""
+	}
+
+	consumerKey := r.Header.Get("X-Ovh-Consumer")
+	if consumerKey != "" && consumerKey != ConsumerKey {
+		return http.StatusForbidden, "INVALID_CREDENTIAL", "This credential is not valid"
+	}
+
+	timestamp, err := strconv.ParseInt(r.Header.Get("X-Ovh-Timestamp"), 10, 64)
+	if err != nil {
+		return http.StatusBadRequest, "INVALID_SIGNATURE", "Invalid signature"
+	}
+	if skew := time.Duration(timestamp-now.Unix()) * time.Second; skew > MaxTimeSkew || skew < -MaxTimeSkew {
+		return http.StatusBadRequest, "QUERY_TIME_OUT", "Query out of time"
+	}
+
+	if VerifySignature(r, ApplicationSecret, consumerKey, timestamp) != nil {
+		return http.StatusBadRequest, "INVALID_SIGNATURE", "Invalid signature"
+	}
+	return 0, "", ""
+}
@@ -0,0 +1,81 @@
+package govhtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+func TestServer(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.Handle("GET /me", http.StatusOK, map[string]string{"nichandle": "xx1234-ovh"})
+	server.HandleFunc("POST /domain/zone/{zoneName}/refresh", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("zoneName") != "example.com" {
+			t.Errorf("unexpected zone %q", r.PathValue("zoneName"))
+		}
+		w.Write([]byte("null"))
+	})
+	server.HandleError("GET /me/bill/{billID}", http.StatusNotFound, "", "This bill does not exist")
+
+	caller := server.Caller()
+
+	var me struct{ Nichandle string }
+	if err := caller.Get("/me", &me); err != nil {
+		t.Fatal(err)
+	}
+	if me.Nichandle != "xx1234-ovh" {
+		t.Fatalf("unexpected result %+v", me)
+	}
+
+	if err := caller.Post("/domain/zone/example.com/refresh", map[string]string{"a": "b"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	request := server.LastRequest()
+	if request.Method != "POST" || string(request.Body) != `{"a":"b"}` || !request.Signed || request.ConsumerKey != ConsumerKey {
+		t.Fatalf("unexpected request %+v", request)
+	}
+
+	if err := caller.Get("/me/bill/FR42", nil); !govh.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if err := caller.Get("/unknown", nil); !govh.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	if len(server.Requests()) != 4 {
+		t.Fatalf("expected 4 recorded requests, got %d", len(server.Requests()))
+	}
+}
+
+func TestServerAuthentication(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Handle("GET /me", http.StatusOK, map[string]string{})
+
+	badSecret := server.Caller()
+	badSecret.ApplicationSecret = "wrong"
+	if err := badSecret.Get("/me", nil); !errors.Is(err, govh.ErrInvalidSignature) {
+		t.Fatalf("expected an invalid signature error, got %v", err)
+	}
+
+	badKey := server.Caller().WithConsumerKey("revoked")
+	if err := badKey.Get("/me", nil); !govh.IsInvalidCredential(err) {
+		t.Fatalf("expected an invalid credential error, got %v", err)
+	}
+
+	// The caller resynchronizes its clock when its timestamps are rejected.
+	caller := server.Caller()
+	if err := caller.Get("/me", nil); err != nil {
+		t.Fatal(err)
+	}
+	server.Now = func() time.Time { return time.Now().Add(time.Hour) }
+	if err := caller.GetWithContext(context.Background(), "/me", nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,108 @@
+package govhtest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// SignatureVector is a sample signature of the OVH signature scheme.
+type SignatureVector struct {
+	ApplicationSecret string
+	ConsumerKey       string
+	Method            string
+	URL               string
+	Body              string
+	Timestamp         int64
+	Signature         string
+}
+
+// SignatureVectors are sample signatures computed with the formula documented
+// by OVH: "$1$" followed by the hex SHA-1 of the application secret, consumer
+// key, method, URL, body and timestamp joined by "+". They are regression
+// values for implementations of that formula, such as govh.Sign, not answers
+// published by OVH or taken from its SDKs.
+var SignatureVectors = []SignatureVector{
+	{
+		ApplicationSecret: "EXEMPLEm4kGSy4UXBYrpAEYeEbmZuHz",
+		ConsumerKey:       "MtSwSrPpNjqfVSmJhLbPyr2i45lSwPU1",
+		Method:            "GET",
+		URL:               "https://eu.api.ovh.com/1.0/me",
+		Timestamp:         1366560945,
+		Signature:         "$1$6853782eb4bd44959f4d96e1f00869e11d6c1990",
+	},
+	{
+		ApplicationSecret: "EXEMPLEm4kGSy4UXBYrpAEYeEbmZuHz",
+		ConsumerKey:       "MtSwSrPpNjqfVSmJhLbPyr2i45lSwPU1",
+		Method:            "POST",
+		URL:               "https://eu.api.ovh.com/1.0/domain/zone/example.com/record",
+		Body:              `{"fieldType":"A","subDomain":"www","target":"192.0.2.1","ttl":3600}`,
+		Timestamp:         1700000000,
+		Signature:         "$1$46a964c7f41b7422782ef6975317077e7ce57558",
+	},
+	{
+		ApplicationSecret: "EXEMPLEm4kGSy4UXBYrpAEYeEbmZuHz",
+		ConsumerKey:       "MtSwSrPpNjqfVSmJhLbPyr2i45lSwPU1",
+		Method:            "GET",
+		URL:               "https://ca.api.ovh.com/1.0/me/bill?date.from=2024-01-01&date.to=2024-02-01",
+		Timestamp:         1704067200,
+		Signature:         "$1$1a7008b01df56644ef70a266f12c396f38c7abcc",
+	},
+	{
+		ApplicationSecret: "EXEMPLEm4kGSy4UXBYrpAEYeEbmZuHz",
+		ConsumerKey:       "MtSwSrPpNjqfVSmJhLbPyr2i45lSwPU1",
+		Method:            "PUT",
+		URL:               "https://api.us.ovhcloud.com/1.0/me/contact/42",
+		Body:              `{"firstName":"Zoë"}`,
+		Timestamp:         1714000000,
+		Signature:         "$1$eef275e5900a23130601e6d95325f51d3182e355",
+	},
+	{
+		ApplicationSecret: "EXEMPLEm4kGSy4UXBYrpAEYeEbmZuHz",
+		ConsumerKey:       "MtSwSrPpNjqfVSmJhLbPyr2i45lSwPU1",
+		Method:            "DELETE",
+		URL:               "https://eu.api.ovh.com/v2/iam/policy/7d8b2f0c",
+		Timestamp:         1720000000,
+		Signature:         "$1$6fe78f7f72db4bba30d72fd9d39327566c538e67",
+	},
+}
+
+// VerifySignature checks that a request is signed with applicationSecret and
+// consumerKey at the given timestamp, as the API does. It accepts requests
+// built by a client, with a complete URL, as well as requests received by a
+// server, whose URL is completed with the Host header. The request body is
+// read and restored.
+func VerifySignature(request *http.Request, applicationSecret, consumerKey string, timestamp int64) error {
+	if got := request.Header.Get("X-Ovh-Consumer"); got != consumerKey {
+		return fmt.Errorf("Unexpected consumer key %q, expected %q", got, consumerKey)
+	}
+	if got := request.Header.Get("X-Ovh-Timestamp"); got != strconv.FormatInt(timestamp, 10) {
+		return fmt.Errorf("Unexpected timestamp %q, expected %d", got, timestamp)
+	}
+
+	var body []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return err
+		}
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	completeURL := request.URL.String()
+	if request.URL.Host == "" {
+		completeURL = "http://" + request.Host + request.URL.RequestURI()
+	}
+
+	expected := govh.Sign(applicationSecret, consumerKey, request.Method, completeURL, string(body), timestamp)
+	if got := request.Header.Get("X-Ovh-Signature"); got != expected {
+		return fmt.Errorf("Invalid signature %q for %s %s, expected %q", got, request.Method, completeURL, expected)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package govhtest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+func TestSignatureVectors(t *testing.T) {
+	for _, v := range SignatureVectors {
+		if got := govh.Sign(v.ApplicationSecret, v.ConsumerKey, v.Method, v.URL, v.Body, v.Timestamp); got != v.Signature {
+			t.Errorf("%s %s: got signature %q, expected %q", v.Method, v.URL, got, v.Signature)
+		}
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	caller := &govh.Caller{ApplicationKey: ApplicationKey, ApplicationSecret: ApplicationSecret, ConsumerKey: ConsumerKey}
+
+	for _, v := range SignatureVectors {
+		request, err := http.NewRequest(v.Method, v.URL, strings.NewReader(v.Body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := caller.SignRequest(request); err != nil {
+			t.Fatal(err)
+		}
+		timestamp, err := strconv.ParseInt(request.Header.Get("X-Ovh-Timestamp"), 10, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := VerifySignature(request, ApplicationSecret, ConsumerKey, timestamp); err != nil {
+			t.Fatalf("%s %s: %s", v.Method, v.URL, err)
+		}
+		if body, _ := ioutil.ReadAll(request.Body); string(body) != v.Body {
+			t.Fatalf("body was not restored: %q", body)
+		}
+
+		if err := VerifySignature(request, "other secret", ConsumerKey, timestamp); err == nil {
+			t.Fatal("expected an error for another application secret")
+		}
+		if err := VerifySignature(request, ApplicationSecret, "other", timestamp); err == nil {
+			t.Fatal("expected an error for another consumer key")
+		}
+		if err := VerifySignature(request, ApplicationSecret, ConsumerKey, timestamp+1); err == nil {
+			t.Fatal("expected an error for another timestamp")
+		}
+	}
+
+	// Known answer, with the headers set by hand
+	v := SignatureVectors[1]
+	request, _ := http.NewRequest(v.Method, v.URL, strings.NewReader(v.Body+" "))
+	request.Header.Set("X-Ovh-Consumer", v.ConsumerKey)
+	request.Header.Set("X-Ovh-Timestamp", strconv.FormatInt(v.Timestamp, 10))
+	request.Header.Set("X-Ovh-Signature", v.Signature)
+	if err := VerifySignature(request, v.ApplicationSecret, v.ConsumerKey, v.Timestamp); err == nil {
+		t.Fatal("expected an error for a tampered body")
+	}
+	request.Body = ioutil.NopCloser(strings.NewReader(v.Body))
+	if err := VerifySignature(request, v.ApplicationSecret, v.ConsumerKey, v.Timestamp); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,62 @@
+package govh
+
+import "context"
+
+// Client is the interface implemented by *Caller to call the API. Code
+// depending on it rather than on *Caller can be tested with a fake, such as
+// govhtest.Client.
+type Client interface {
+	CallAPI(url, method string, body, typeResult interface{}) error
+	CallAPIWithContext(ctx context.Context, url, method string, body, typeResult interface{}, opts ...CallOption) error
+	CallAPIRaw(ctx context.Context, url, method string, body, typeResult interface{}, opts ...CallOption) (*Response, error)
+	Get(path string, result interface{}) error
+	GetWithContext(ctx context.Context, path string, result interface{}, opts ...CallOption) error
+	Post(path string, body, result interface{}) error
+	PostWithContext(ctx context.Context, path string, body, result interface{}, opts ...CallOption) error
+	Put(path string, body, result interface{}) error
+	PutWithContext(ctx context.Context, path string, body, result interface{}, opts ...CallOption) error
+	Delete(path string, result interface{}) error
+	DeleteWithContext(ctx context.Context, path string, result interface{}, opts ...CallOption) error
+}
+
+var _ Client = (*Caller)(nil)
+
+// Get is a wrapper for CallAPI performing a GET request.
+func (caller *Caller) Get(path string, result interface{}) error {
+	return caller.CallAPI(path, "GET", nil, result)
+}
+
+// GetWithContext is a wrapper for CallAPIWithContext performing a GET request.
+func (caller *Caller) GetWithContext(ctx context.Context, path string, result interface{}, opts ...CallOption) error {
+	return caller.CallAPIWithContext(ctx, path, "GET", nil, result, opts...)
+}
+
+// Post is a wrapper for CallAPI performing a POST request.
+func (caller *Caller) Post(path string, body, result interface{}) error {
+	return caller.CallAPI(path, "POST", body, result)
+}
+
+// PostWithContext is a wrapper for CallAPIWithContext performing a POST request.
+func (caller *Caller) PostWithContext(ctx context.Context, path string, body, result interface{}, opts ...CallOption) error {
+	return caller.CallAPIWithContext(ctx, path, "POST", body, result, opts...)
+}
+
+// Put is a wrapper for CallAPI performing a PUT request.
+func (caller *Caller) Put(path string, body, result interface{}) error {
+	return caller.CallAPI(path, "PUT", body, result)
+}
+
+// PutWithContext is a wrapper for CallAPIWithContext performing a PUT request.
+func (caller *Caller) PutWithContext(ctx context.Context, path string, body, result interface{}, opts ...CallOption) error {
+	return caller.CallAPIWithContext(ctx, path, "PUT", body, result, opts...)
+}
+
+// Delete is a wrapper for CallAPI performing a DELETE request.
+func (caller *Caller) Delete(path string, result interface{}) error {
+	return caller.CallAPI(path, "DELETE", nil, result)
+}
+
+// DeleteWithContext is a wrapper for CallAPIWithContext performing a DELETE request.
+func (caller *Caller) DeleteWithContext(ctx context.Context, path string, result interface{}, opts ...CallOption) error {
+	return caller.CallAPIWithContext(ctx, path, "DELETE", nil, result, opts...)
+}
@@ -0,0 +1,187 @@
+package govh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+// IDCursor iterates over the identifiers returned by a list route.
+//
+//	cursor, err := caller.List(ctx, "/domain/zone")
+//	for cursor.Next() {
+//		fmt.Println(cursor.ID())
+//	}
+type IDCursor struct {
+	ids []string
+	pos int
+}
+
+// Next advances the cursor to the next identifier, and reports whether there
+// is one.
+func (cursor *IDCursor) Next() bool {
+	if cursor.pos >= len(cursor.ids) {
+		return false
+	}
+	cursor.pos++
+	return true
+}
+
+// ID returns the current identifier.
+func (cursor *IDCursor) ID() string {
+	if cursor.pos == 0 || cursor.pos > len(cursor.ids) {
+		return ""
+	}
+	return cursor.ids[cursor.pos-1]
+}
+
+// Len returns the total number of identifiers.
+func (cursor *IDCursor) Len() int {
+	return len(cursor.ids)
+}
+
+// IDs returns all the identifiers.
+func (cursor *IDCursor) IDs() []string {
+	return cursor.ids
+}
+
+// List calls a route returning an array of identifiers, such as /domain/zone
+// or /me/bill. Numeric identifiers are returned in their decimal form.
+func (caller *Caller) List(ctx context.Context, path string, opts ...CallOption) (*IDCursor, error) {
+	var raw []json.RawMessage
+	if err := caller.CallAPIWithContext(ctx, path, "GET", nil, &raw, opts...); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(raw))
+	for i, r := range raw {
+		var s string
+		if err := json.Unmarshal(r, &s); err == nil {
+			ids[i] = s
+			continue
+		}
+
+		var n json.Number
+		if err := json.Unmarshal(r, &n); err != nil {
+			return nil, fmt.Errorf("Unexpected identifier %s in %s response", r, path)
+		}
+		ids[i] = n.String()
+	}
+
+	return &IDCursor{ids: ids}, nil
+}
+
+// FetchEach lists identifiers from listPath, then fetches the details of each
+// of them, with at most concurrency calls in flight. The details path format
+// must contain a single %s verb, replaced by the escaped identifier, e.g.
+// "/domain/zone/%s".
+//
+// Fn is called with the raw details of each resource, possibly concurrently.
+// The first error, returned by fn or by a call, stops the fetching.
+func (caller *Caller) FetchEach(ctx context.Context, listPath, detailFormat string, concurrency int, fn func(id string, detail json.RawMessage) error) error {
+	cursor, err := caller.List(ctx, listPath)
+	if err != nil {
+		return err
+	}
+
+	return caller.fetchEach(ctx, cursor.IDs(), detailFormat, concurrency, fn)
+}
+
+func (caller *Caller) fetchEach(ctx context.Context, ids []string, detailFormat string, concurrency int, fn func(id string, detail json.RawMessage) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		jobs     = make(chan string)
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				var detail json.RawMessage
+				path := fmt.Sprintf(detailFormat, url.PathEscape(id))
+				if err := caller.CallAPIWithContext(ctx, path, "GET", nil, &detail); err != nil {
+					fail(err)
+					continue
+				}
+				if err := fn(id, detail); err != nil {
+					fail(err)
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, id := range ids {
+		select {
+		case jobs <- id:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr == nil && ctx.Err() != nil {
+		// The parent context was cancelled.
+		return ctx.Err()
+	}
+	return firstErr
+}
+
+// FetchAll lists identifiers from listPath, then fetches the details of each
+// of them like FetchEach, and returns them in the order of the list:
+//
+//	zones, err := govh.FetchAll[Zone](ctx, caller, "/domain/zone", "/domain/zone/%s", 10)
+//
+// Calls go through the rate limiter and retry policy of the caller; with a
+// rate limiter, concurrency is capped to its burst, as further calls would
+// only wait for it. The first error stops the fetching.
+func FetchAll[T any](ctx context.Context, caller *Caller, listPath, detailFormat string, concurrency int) ([]T, error) {
+	cursor, err := caller.List(ctx, listPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if caller.RateLimiter != nil && concurrency > int(caller.RateLimiter.burst) {
+		concurrency = int(caller.RateLimiter.burst)
+	}
+
+	positions := make(map[string][]int, cursor.Len())
+	for i, id := range cursor.IDs() {
+		positions[id] = append(positions[id], i)
+	}
+
+	// Each position is taken by a single worker, which writes its element.
+	results := make([]T, cursor.Len())
+	var mu sync.Mutex
+	err = caller.fetchEach(ctx, cursor.IDs(), detailFormat, concurrency, func(id string, detail json.RawMessage) error {
+		mu.Lock()
+		i := positions[id][0]
+		positions[id] = positions[id][1:]
+		mu.Unlock()
+
+		return caller.decode(detail, &results[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,122 @@
+package govh
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestListAndFetchEach(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/me/bill" {
+			w.Write([]byte(`["FR123", 456]`))
+			return
+		}
+		w.Write([]byte(`{"billId":"` + strings.TrimPrefix(r.URL.Path, "/me/bill/") + `"}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+
+	cursor, err := c.List(context.Background(), "/me/bill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for cursor.Next() {
+		ids = append(ids, cursor.ID())
+	}
+	if len(ids) != 2 || ids[0] != "FR123" || ids[1] != "456" {
+		t.Fatalf("unexpected ids %v", ids)
+	}
+
+	var (
+		mu      sync.Mutex
+		fetched []string
+	)
+	err = c.FetchEach(context.Background(), "/me/bill", "/me/bill/%s", 2, func(id string, detail json.RawMessage) error {
+		var bill struct{ BillID string }
+		if err := json.Unmarshal(detail, &bill); err != nil {
+			return err
+		}
+		mu.Lock()
+		fetched = append(fetched, bill.BillID)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(fetched)
+	if len(fetched) != 2 || fetched[0] != "456" || fetched[1] != "FR123" {
+		t.Fatalf("unexpected details %v", fetched)
+	}
+}
+
+func TestFetchAll(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		inFlight  int
+		maxFlight int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/me/bill":
+			w.Write([]byte(`["FR1", "FR2", "FR3", "FR4", "FR5", "FR6"]`))
+			return
+		case "/me/deposit":
+			w.Write([]byte(`["FR1", "FR404"]`))
+			return
+		}
+		mu.Lock()
+		inFlight++
+		if inFlight > maxFlight {
+			maxFlight = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		if r.URL.Path == "/me/bill/FR404" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"This bill does not exist"}`))
+			return
+		}
+		w.Write([]byte(`{"billId":"` + strings.TrimPrefix(r.URL.Path, "/me/bill/") + `"}`))
+	}))
+	defer server.Close()
+
+	type bill struct {
+		BillID string `json:"billId"`
+	}
+
+	c := &Caller{URL: server.URL, RateLimiter: NewRateLimiter(1000, 2)}
+	bills, err := FetchAll[bill](context.Background(), c, "/me/bill", "/me/bill/%s", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bills) != 6 {
+		t.Fatalf("unexpected bills %+v", bills)
+	}
+	for i, b := range bills {
+		if b.BillID != "FR"+string(rune('1'+i)) {
+			t.Fatalf("unexpected order %+v", bills)
+		}
+	}
+	if maxFlight > 2 {
+		t.Fatalf("expected at most 2 calls in flight, got %d", maxFlight)
+	}
+
+	if _, err := FetchAll[bill](context.Background(), c, "/me/deposit", "/me/bill/%s", 2); !IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+package govh
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Logger is the interface used by a Caller to log requests.
+// It is implemented by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// LogLevel sets how much a Caller logs about each request.
+type LogLevel int
+
+const (
+	// LogRequests logs a line per request, with its method, URL, status,
+	// duration and query ID.
+	LogRequests LogLevel = iota
+	// LogHeaders also logs the headers of requests and responses.
+	LogHeaders
+	// LogBodies also logs the bodies of requests and responses, up to
+	// maxLoggedBody bytes. Response bodies are then read in memory, even
+	// for streamed calls.
+	LogBodies
+)
+
+// redacted replaces secrets in logs.
+const redacted = "REDACTED"
+
+// maxLoggedBody is the length above which logged bodies are truncated.
+const maxLoggedBody = 4096
+
+// redactedHeaders are the headers whose value is never logged.
+var redactedHeaders = map[string]bool{
+	"Authorization":   true,
+	"X-Ovh-Signature": true,
+	"X-Ovh-Consumer":  true,
+}
+
+// secretFields matches the JSON fields whose values are never logged, such
+// as the consumer key returned by POST /auth/credential, or passwords sent to
+// the API.
+var secretFields = regexp.MustCompile(`("(?:consumerKey|password|applicationSecret|client_secret|access_token)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// logRequest logs a request made to the API, with its outcome. At the
+// LogBodies level, the response body is read and replaced by a copy.
+func (caller *Caller) logRequest(request *http.Request, params []byte, result *http.Response, err error, duration time.Duration) {
+	if caller.Logger == nil {
+		return
+	}
+
+	if err != nil {
+		caller.Logger.Printf("govh: %s %s: %s (%s)", request.Method, request.URL, err, duration)
+	} else if queryID := result.Header.Get("X-Ovh-QueryID"); queryID != "" {
+		caller.Logger.Printf("govh: %s %s: %d (%s) query_id=%s", request.Method, request.URL, result.StatusCode, duration, queryID)
+	} else {
+		caller.Logger.Printf("govh: %s %s: %d (%s)", request.Method, request.URL, result.StatusCode, duration)
+	}
+
+	if caller.LogLevel >= LogHeaders {
+		caller.Logger.Printf("govh: > %s", formatHeader(request.Header))
+		if err == nil {
+			caller.Logger.Printf("govh: < %s", formatHeader(result.Header))
+		}
+	}
+
+	if caller.LogLevel >= LogBodies {
+		if len(params) > 0 {
+			caller.Logger.Printf("govh: > %s", caller.redactBody(params))
+		}
+		if err == nil {
+			body, readErr := ioutil.ReadAll(result.Body)
+			result.Body.Close()
+			result.Body = ioutil.NopCloser(bytes.NewReader(body))
+			if readErr != nil {
+				caller.Logger.Printf("govh: < %s", readErr)
+			} else if len(body) > 0 {
+				caller.Logger.Printf("govh: < %s", caller.redactBody(body))
+			}
+		}
+	}
+}
+
+// formatHeader formats headers on a single line, sorted by name, redacting
+// credentials.
+func formatHeader(header http.Header) string {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, name+": "+RedactHeader(name, strings.Join(header[name], ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// RedactHeader returns the value of a header to show, which is redacted for
+// headers holding credentials, such as Authorization or X-Ovh-Consumer.
+func RedactHeader(name, value string) string {
+	if redactedHeaders[http.CanonicalHeaderKey(name)] {
+		return redacted
+	}
+	return value
+}
+
+// RedactBody returns body without the values of its secret JSON fields, such
+// as consumerKey or password, and without the given secrets.
+func RedactBody(body string, secrets ...string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			body = strings.Replace(body, secret, redacted, -1)
+		}
+	}
+	return secretFields.ReplaceAllString(body, `${1}"`+redacted+`"`)
+}
+
+// redactBody returns a body to log, without the secrets of the caller and of
+// its AuthProvider, and truncated. Secrets are redacted first, so that none
+// is cut in the middle.
+func (caller *Caller) redactBody(body []byte) string {
+	s := RedactBody(string(body), caller.secrets()...)
+	if len(s) > maxLoggedBody {
+		s = strings.ToValidUTF8(s[:maxLoggedBody], "") + "..."
+	}
+	return s
+}
+
+// secrets returns the secrets of the caller, and those of its AuthProvider.
+func (caller *Caller) secrets() []string {
+	consumerKey, _ := caller.credentials()
+	secrets := []string{caller.ApplicationSecret, consumerKey}
+	if holder, ok := caller.Auth.(SecretHolder); ok {
+		secrets = append(secrets, holder.Secrets()...)
+	}
+	return secrets
+}
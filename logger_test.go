@@ -0,0 +1,94 @@
+package govh
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLogLevels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ovh-QueryID", "EU.ext-1.abc")
+		w.Write([]byte(`{"consumerKey":"MtSwSrPpNjqfVSmJhLbPyr2i45lSwPU1","state":"validated"}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	c := &Caller{
+		URL:               server.URL,
+		ApplicationKey:    "7kbG7Bk7S9Nt7ZSV",
+		ApplicationSecret: "EXEgWIz07P0HYwtQDs7cNIqCiQaWSuHF",
+		ConsumerKey:       "MtSwSrPpNjqfVSmJhLbPyr2i45lSwPU1",
+		Logger:            log.New(&logs, "", 0),
+	}
+
+	var result struct{ State string }
+	if err := c.Post("/me/secret", map[string]string{"secret": c.ApplicationSecret}, &result); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(logs.String(), "\n"); lines != 1 || !strings.Contains(logs.String(), ": 200 (") ||
+		!strings.Contains(logs.String(), "query_id=EU.ext-1.abc") {
+		t.Fatalf("unexpected logs %q", logs.String())
+	}
+
+	logs.Reset()
+	c.LogLevel = LogBodies
+	if err := c.Post("/me/secret", map[string]string{"secret": c.ApplicationSecret}, &result); err != nil {
+		t.Fatal(err)
+	}
+	if result.State != "validated" {
+		t.Fatalf("response body was not restored: %+v", result)
+	}
+
+	output := logs.String()
+	if strings.Contains(output, c.ApplicationSecret) || strings.Contains(output, c.ConsumerKey) {
+		t.Fatalf("secrets were logged: %q", output)
+	}
+	for _, expected := range []string{
+		"X-Ovh-Application: 7kbG7Bk7S9Nt7ZSV",
+		"X-Ovh-Signature: REDACTED",
+		"X-Ovh-Consumer: REDACTED",
+		"X-Ovh-Queryid: EU.ext-1.abc",
+		`> {"secret":"REDACTED"}`,
+		`< {"consumerKey":"REDACTED","state":"validated"}`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in logs %q", expected, output)
+		}
+	}
+}
+
+func TestLogRedaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"consumerKey":"nEwCoNsUmErKeY","state":"pendingValidation"}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	c := &Caller{
+		URL:      server.URL,
+		Auth:     NewCredentialsAuth(StaticCredentials("ak", "pRoViDeRsEcReT", "pRoViDeRcK")),
+		Logger:   log.New(&logs, "", 0),
+		LogLevel: LogBodies,
+	}
+
+	if err := c.Post("/auth/credential", map[string]string{"password": "hunter2", "secret": "pRoViDeRsEcReT"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	output := logs.String()
+	for _, secret := range []string{"nEwCoNsUmErKeY", "hunter2", "pRoViDeRsEcReT", "pRoViDeRcK"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("%s was logged: %q", secret, output)
+		}
+	}
+
+	// A secret straddling the truncation is redacted before being cut
+	c.ApplicationSecret = "sTrAdDlInGsEcReT"
+	body := strings.Repeat("x", maxLoggedBody-4) + c.ApplicationSecret
+	if logged := c.redactBody([]byte(body)); strings.Contains(logged, "sTrA") {
+		t.Fatalf("the prefix of a secret was logged: %q", logged[len(logged)-16:])
+	}
+}
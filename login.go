@@ -0,0 +1,73 @@
+package govh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+)
+
+// Login requests a new consumer key with params, hands its validation URL to
+// display, and waits for the user to validate it. On success the consumer key
+// is set on the caller and returned, so a CLI tool can log in with:
+//
+//	params := &govh.GetCKParams{AccessRules: govh.AllRules()}
+//	ck, err := caller.Login(ctx, params, govh.PrintValidationURL(os.Stderr))
+//
+// The caller's consumer key is left empty if the key is not validated.
+func (caller *Caller) Login(ctx context.Context, params *GetCKParams, display func(validationURL string) error) (string, error) {
+	response, err := caller.GetConsumerKeyWithContext(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	if display != nil {
+		if err := display(response.ValidationURL); err != nil {
+			caller.SetConsumerKey("")
+			return "", err
+		}
+	}
+
+	if err := caller.WaitForValidation(ctx, 0); err != nil {
+		caller.SetConsumerKey("")
+		return "", err
+	}
+	return response.ConsumerKey, nil
+}
+
+// PrintValidationURL returns a Login display function writing the validation
+// URL to w, with instructions for the user.
+func PrintValidationURL(w io.Writer) func(string) error {
+	return func(validationURL string) error {
+		_, err := fmt.Fprintf(w, "Please visit %s to validate the consumer key.\nWaiting for validation...\n", validationURL)
+		return err
+	}
+}
+
+// OpenValidationURL returns a Login display function opening the validation
+// URL in the user's browser. The URL is also written to w, in case no
+// browser can be opened.
+func OpenValidationURL(w io.Writer) func(string) error {
+	printURL := PrintValidationURL(w)
+	return func(validationURL string) error {
+		openBrowser(validationURL)
+		return printURL(validationURL)
+	}
+}
+
+// openBrowser opens u in the default browser, on a best effort basis.
+func openBrowser(u string) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", u)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+	if cmd.Start() == nil {
+		go cmd.Wait()
+	}
+}
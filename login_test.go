@@ -0,0 +1,48 @@
+package govh
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLogin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/credential":
+			w.Write([]byte(`{"consumerKey":"ck","state":"pendingValidation","validationUrl":"https://example.com/validate"}`))
+		case "/auth/currentCredential":
+			if r.Header.Get("X-Ovh-Consumer") != "ck" {
+				t.Errorf("unexpected consumer key %q", r.Header.Get("X-Ovh-Consumer"))
+			}
+			w.Write([]byte(`{"status":"validated"}`))
+		}
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	c := &Caller{URL: server.URL, ApplicationKey: "ak", ApplicationSecret: "as"}
+	ck, err := c.Login(context.Background(), &GetCKParams{AccessRules: AllRules()}, PrintValidationURL(&out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ck != "ck" || c.ConsumerKey != "ck" {
+		t.Fatalf("unexpected consumer key %q, caller has %q", ck, c.ConsumerKey)
+	}
+	if !strings.Contains(out.String(), "https://example.com/validate") {
+		t.Fatalf("validation URL not printed: %q", out.String())
+	}
+
+	displayErr := errors.New("no terminal")
+	_, err = c.Login(context.Background(), &GetCKParams{}, func(string) error { return displayErr })
+	if err != displayErr {
+		t.Fatalf("expected display error, got %v", err)
+	}
+	if c.ConsumerKey != "" {
+		t.Fatalf("expected consumer key to be cleared, got %q", c.ConsumerKey)
+	}
+}
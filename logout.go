@@ -0,0 +1,21 @@
+package govh
+
+import "context"
+
+// Logout revokes the caller's consumer key, by asking POST /auth/logout.
+// On success the consumer key is cleared, so it is not used anymore. Dry-run
+// callers keep it, as nothing was revoked.
+func (caller *Caller) Logout() error {
+	return caller.LogoutWithContext(context.Background())
+}
+
+// LogoutWithContext is like Logout, but the request is bound to ctx.
+func (caller *Caller) LogoutWithContext(ctx context.Context) error {
+	if err := caller.CallAPIWithContext(ctx, "/auth/logout", "POST", nil, nil); err != nil {
+		return err
+	}
+	if !caller.DryRun {
+		caller.SetConsumerKey("")
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package govh
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestLogout(t *testing.T) {
+	var consumer string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/auth/logout" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		consumer = r.Header.Get("X-Ovh-Consumer")
+		w.Write([]byte("null"))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "ck"}
+	if err := c.Logout(); err != nil {
+		t.Fatal(err)
+	}
+	if consumer != "ck" {
+		t.Fatalf("expected request signed with ck, got %q", consumer)
+	}
+	if c.ConsumerKey != "" {
+		t.Fatalf("expected consumer key to be cleared, got %q", c.ConsumerKey)
+	}
+}
+
+func TestLogoutDryRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			w.Write([]byte(strconv.FormatInt(time.Now().Unix(), 10)))
+			return
+		}
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "ck", DryRun: true}
+	if err := c.Logout(); err != nil {
+		t.Fatal(err)
+	}
+	if c.ConsumerKey != "ck" {
+		t.Fatalf("expected consumer key to be kept, got %q", c.ConsumerKey)
+	}
+}
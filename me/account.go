@@ -0,0 +1,86 @@
+package me
+
+import "context"
+
+// Account is the nichandle of the authenticated user, as returned by GET /me.
+type Account struct {
+	Nichandle    string `json:"nichandle"`
+	CustomerCode string `json:"customerCode"`
+	Email        string `json:"email"`
+	SpareEmail   string `json:"spareEmail"`
+	FirstName    string `json:"firstname"`
+	Name         string `json:"name"`
+	Organisation string `json:"organisation"`
+	// Legal form: individual, corporation, association, administration,
+	// personalCorporation or other.
+	LegalForm                           string   `json:"legalform"`
+	CorporationType                     string   `json:"corporationType"`
+	CompanyNationalIdentificationNumber string   `json:"companyNationalIdentificationNumber"`
+	NationalIdentificationNumber        string   `json:"nationalIdentificationNumber"`
+	VAT                                 string   `json:"vat"`
+	Address                             string   `json:"address"`
+	Zip                                 string   `json:"zip"`
+	City                                string   `json:"city"`
+	Area                                string   `json:"area"`
+	Country                             string   `json:"country"`
+	Language                            string   `json:"language"`
+	Phone                               string   `json:"phone"`
+	PhoneCountry                        string   `json:"phoneCountry"`
+	Fax                                 string   `json:"fax"`
+	BirthDay                            string   `json:"birthDay"`
+	BirthCity                           string   `json:"birthCity"`
+	Sex                                 string   `json:"sex"`
+	Currency                            Currency `json:"currency"`
+	OvhCompany                          string   `json:"ovhCompany"`
+	OvhSubsidiary                       string   `json:"ovhSubsidiary"`
+	// State of the account information: complete or incomplete.
+	State        string `json:"state"`
+	KYCValidated bool   `json:"kycValidated"`
+}
+
+// Currency is the currency used by an account.
+type Currency struct {
+	Code   string `json:"code"`
+	Symbol string `json:"symbol"`
+}
+
+// AccountUpdate lists the account fields to modify. Empty fields are left
+// unchanged.
+type AccountUpdate struct {
+	Email                               string `json:"email,omitempty"`
+	SpareEmail                          string `json:"spareEmail,omitempty"`
+	FirstName                           string `json:"firstname,omitempty"`
+	Name                                string `json:"name,omitempty"`
+	Organisation                        string `json:"organisation,omitempty"`
+	LegalForm                           string `json:"legalform,omitempty"`
+	CorporationType                     string `json:"corporationType,omitempty"`
+	CompanyNationalIdentificationNumber string `json:"companyNationalIdentificationNumber,omitempty"`
+	NationalIdentificationNumber        string `json:"nationalIdentificationNumber,omitempty"`
+	VAT                                 string `json:"vat,omitempty"`
+	Address                             string `json:"address,omitempty"`
+	Zip                                 string `json:"zip,omitempty"`
+	City                                string `json:"city,omitempty"`
+	Area                                string `json:"area,omitempty"`
+	Country                             string `json:"country,omitempty"`
+	Language                            string `json:"language,omitempty"`
+	Phone                               string `json:"phone,omitempty"`
+	PhoneCountry                        string `json:"phoneCountry,omitempty"`
+	Fax                                 string `json:"fax,omitempty"`
+	BirthDay                            string `json:"birthDay,omitempty"`
+	BirthCity                           string `json:"birthCity,omitempty"`
+	Sex                                 string `json:"sex,omitempty"`
+}
+
+// Account returns the account of the authenticated user.
+func (client *Client) Account(ctx context.Context) (*Account, error) {
+	account := &Account{}
+	if err := client.api.GetWithContext(ctx, "/me", account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// UpdateAccount modifies the account of the authenticated user.
+func (client *Client) UpdateAccount(ctx context.Context, update *AccountUpdate) error {
+	return client.api.PutWithContext(ctx, "/me", update, nil)
+}
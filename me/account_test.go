@@ -0,0 +1,38 @@
+package me
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestAccount(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.HandleFixtures()
+	server.Handle("PUT /me", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	account, err := client.Account(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.Nichandle != "xx1234-ovh" || account.FirstName != "John" || account.Currency.Code != "EUR" || account.State != "complete" {
+		t.Fatalf("unexpected account %+v", account)
+	}
+
+	if err := client.UpdateAccount(ctx, &AccountUpdate{Phone: "+33.987654321", City: "Lille"}); err != nil {
+		t.Fatal(err)
+	}
+	request := server.LastRequest()
+	if request.Method != "PUT" || string(request.Body) != `{"city":"Lille","phone":"+33.987654321"}` {
+		t.Fatalf("unexpected request %s %s", request.Method, request.Body)
+	}
+}
+
+func TestAccountFixture(t *testing.T) {
+	govhtest.DecodeFixture(t, "me", &Account{})
+}
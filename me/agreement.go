@@ -0,0 +1,97 @@
+package me
+
+import (
+	"context"
+	"fmt"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Agreement states.
+const (
+	AgreementTodo = "todo"
+	AgreementOK   = "ok"
+	AgreementKO   = "ko"
+)
+
+// Agreement is the acceptance of a contract by the account.
+type Agreement struct {
+	ID         int64 `json:"id"`
+	ContractID int64 `json:"contractId"`
+	// State of the agreement: AgreementTodo, AgreementOK or AgreementKO.
+	Agreed string        `json:"agreed"`
+	Date   govh.DateTime `json:"date"`
+}
+
+// Contract is a contract to agree to.
+type Contract struct {
+	Name   string `json:"name"`
+	Text   string `json:"text"`
+	PDF    string `json:"pdf"`
+	Active bool   `json:"active"`
+}
+
+// AgreementFilter filters the agreements returned by Agreements.
+type AgreementFilter struct {
+	Agreed     string `url:"agreed,omitempty"`
+	ContractID int64  `url:"contractId,omitempty"`
+}
+
+// Agreements returns the identifiers of the agreements of the account. A nil
+// filter returns all of them.
+func (client *Client) Agreements(ctx context.Context, filter *AgreementFilter) ([]int64, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, "/me/agreements", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Agreement returns the details of an agreement.
+func (client *Client) Agreement(ctx context.Context, agreementID int64) (*Agreement, error) {
+	agreement := &Agreement{}
+	if err := client.api.GetWithContext(ctx, agreementPath(agreementID), agreement); err != nil {
+		return nil, err
+	}
+	return agreement, nil
+}
+
+// AgreementContract returns the contract of an agreement.
+func (client *Client) AgreementContract(ctx context.Context, agreementID int64) (*Contract, error) {
+	contract := &Contract{}
+	if err := client.api.GetWithContext(ctx, agreementPath(agreementID)+"/contract", contract); err != nil {
+		return nil, err
+	}
+	return contract, nil
+}
+
+// AcceptAgreement accepts the contract of an agreement.
+func (client *Client) AcceptAgreement(ctx context.Context, agreementID int64) error {
+	return client.api.PostWithContext(ctx, agreementPath(agreementID)+"/accept", nil, nil)
+}
+
+// AcceptPendingAgreements accepts all the contracts waiting for agreement,
+// which block orders, and returns the identifiers of the accepted
+// agreements.
+func (client *Client) AcceptPendingAgreements(ctx context.Context) ([]int64, error) {
+	ids, err := client.Agreements(ctx, &AgreementFilter{Agreed: AgreementTodo})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		if err := client.AcceptAgreement(ctx, id); err != nil {
+			return ids[:i], err
+		}
+	}
+	return ids, nil
+}
+
+func agreementPath(agreementID int64) string {
+	return fmt.Sprintf("/me/agreements/%d", agreementID)
+}
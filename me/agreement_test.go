@@ -0,0 +1,45 @@
+package me
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestAgreements(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /me/agreements", 200, []int64{3, 4})
+	server.Handle("GET /me/agreements/3", 200, map[string]interface{}{"id": 3, "contractId": 30, "agreed": "todo", "date": "2024-03-01T10:00:00+01:00"})
+	server.Handle("GET /me/agreements/3/contract", 200, map[string]interface{}{"name": "General Terms", "pdf": "https://example.com/terms.pdf", "active": true})
+	server.Handle("POST /me/agreements/{id}/accept", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	agreement, err := client.Agreement(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if agreement.Agreed != AgreementTodo || agreement.ContractID != 30 {
+		t.Fatalf("unexpected agreement %+v", agreement)
+	}
+
+	contract, err := client.AgreementContract(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contract.Name != "General Terms" || !contract.Active {
+		t.Fatalf("unexpected contract %+v", contract)
+	}
+
+	accepted, err := client.AcceptPendingAgreements(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requests := server.Requests()
+	if len(accepted) != 2 || requests[len(requests)-3].Query.Get("agreed") != "todo" || requests[len(requests)-1].Path != "/me/agreements/4/accept" {
+		t.Fatalf("unexpected accepted agreements %v", accepted)
+	}
+}
@@ -0,0 +1,100 @@
+package me
+
+import (
+	"context"
+	"fmt"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Application is an API application registered on the account.
+type Application struct {
+	ApplicationID  int64  `json:"applicationId"`
+	ApplicationKey string `json:"applicationKey"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	// Status of the application: active, blocked, inactive or trusted.
+	Status string `json:"status"`
+}
+
+// Credential is a consumer key giving an application access to the account.
+type Credential = govh.CurrentCredential
+
+// CredentialFilter filters the consumer keys returned by Credentials.
+type CredentialFilter struct {
+	ApplicationID int64  `url:"applicationId,omitempty"`
+	Status        string `url:"status,omitempty"`
+}
+
+// Applications returns the identifiers of the applications registered on the
+// account.
+func (client *Client) Applications(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, "/me/api/application", &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Application returns the details of an application.
+func (client *Client) Application(ctx context.Context, applicationID int64) (*Application, error) {
+	application := &Application{}
+	if err := client.api.GetWithContext(ctx, fmt.Sprintf("/me/api/application/%d", applicationID), application); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// DeleteApplication removes an application, revoking all its consumer keys.
+func (client *Client) DeleteApplication(ctx context.Context, applicationID int64) error {
+	return client.api.DeleteWithContext(ctx, fmt.Sprintf("/me/api/application/%d", applicationID), nil)
+}
+
+// Credentials returns the identifiers of the consumer keys having access to
+// the account. A nil filter returns all of them.
+func (client *Client) Credentials(ctx context.Context, filter *CredentialFilter) ([]int64, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, "/me/api/credential", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Credential returns the details of a consumer key, including its rules.
+func (client *Client) Credential(ctx context.Context, credentialID int64) (*Credential, error) {
+	credential := &Credential{}
+	if err := client.api.GetWithContext(ctx, credentialPath(credentialID), credential); err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+// CredentialApplication returns the application owning a consumer key.
+func (client *Client) CredentialApplication(ctx context.Context, credentialID int64) (*Application, error) {
+	application := &Application{}
+	if err := client.api.GetWithContext(ctx, credentialPath(credentialID)+"/application", application); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// SetCredentialAllowedIPs restricts a consumer key to the given IP blocks.
+// An empty list lifts the restriction.
+func (client *Client) SetCredentialAllowedIPs(ctx context.Context, credentialID int64, allowedIPs []string) error {
+	body := map[string]interface{}{"allowedIPs": allowedIPs}
+	return client.api.PutWithContext(ctx, credentialPath(credentialID), body, nil)
+}
+
+// DeleteCredential revokes a consumer key.
+func (client *Client) DeleteCredential(ctx context.Context, credentialID int64) error {
+	return client.api.DeleteWithContext(ctx, credentialPath(credentialID), nil)
+}
+
+func credentialPath(credentialID int64) string {
+	return fmt.Sprintf("/me/api/credential/%d", credentialID)
+}
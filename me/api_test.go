@@ -0,0 +1,78 @@
+package me
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	govh "github.com/garbage-collector/ovh-go"
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /me/api/credential":
+			if r.URL.RawQuery != "applicationId=42&status=validated" {
+				t.Errorf("unexpected query %q", r.URL.RawQuery)
+			}
+			w.Write([]byte(`[1, 2]`))
+		case "GET /me/api/credential/2":
+			w.Write([]byte(`{"credentialId":2,"applicationId":42,"status":"validated","rules":[{"method":"GET","path":"/*"}]}`))
+		case "GET /me/api/credential/2/application":
+			w.Write([]byte(`{"applicationId":42,"applicationKey":"ak","name":"backup","status":"active"}`))
+		case "DELETE /me/api/credential/2":
+			w.Write([]byte(`null`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := New(&govh.Caller{URL: server.URL})
+	ctx := context.Background()
+
+	ids, err := client.Credentials(ctx, &CredentialFilter{ApplicationID: 42, Status: "validated"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("expected 2 credentials, got %v", ids)
+	}
+
+	credential, err := client.Credential(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credential.ApplicationID != 42 || len(credential.Rules) != 1 || credential.Rules[0].Path != "/*" {
+		t.Fatalf("unexpected credential %+v", credential)
+	}
+
+	application, err := client.CredentialApplication(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if application.Name != "backup" {
+		t.Fatalf("unexpected application %+v", application)
+	}
+
+	if err := client.DeleteCredential(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestApplicationWithFakeClient(t *testing.T) {
+	fake := &govhtest.Client{Func: func(ctx context.Context, method, path string, body interface{}) (interface{}, error) {
+		return map[string]interface{}{"applicationId": 42, "name": "backup"}, nil
+	}}
+
+	application, err := New(fake).Application(context.Background(), 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if application.Name != "backup" || len(fake.Calls) != 1 || fake.Calls[0].Path != "/me/api/application/42" {
+		t.Fatalf("unexpected application %+v after calls %+v", application, fake.Calls)
+	}
+}
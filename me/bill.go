@@ -0,0 +1,134 @@
+package me
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Bill is an invoice of the account.
+type Bill struct {
+	BillID  string        `json:"billId"`
+	Date    govh.DateTime `json:"date"`
+	OrderID int64         `json:"orderId"`
+	// Category of the bill: autorenew, earlyrenewal, purchase, ...
+	Category        string     `json:"category"`
+	PriceWithTax    govh.Price `json:"priceWithTax"`
+	PriceWithoutTax govh.Price `json:"priceWithoutTax"`
+	Tax             govh.Price `json:"tax"`
+	// URL of the bill as a web page, and as a PDF document. Both embed
+	// Password, and can be opened without authentication.
+	URL      string `json:"url"`
+	PDFURL   string `json:"pdfUrl"`
+	Password string `json:"password"`
+}
+
+// BillDetail is a line of a bill.
+type BillDetail struct {
+	BillDetailID         string     `json:"billDetailId"`
+	OriginalBillDetailID string     `json:"originalBillDetailId"`
+	ServiceID            int64      `json:"serviceId"`
+	Description          string     `json:"description"`
+	Domain               string     `json:"domain"`
+	PeriodStart          govh.Date  `json:"periodStart"`
+	PeriodEnd            govh.Date  `json:"periodEnd"`
+	Quantity             string     `json:"quantity"`
+	UnitPrice            govh.Price `json:"unitPrice"`
+	TotalPrice           govh.Price `json:"totalPrice"`
+}
+
+// BillFilter filters the bills returned by Bills.
+type BillFilter struct {
+	From     time.Time `url:"date.from,omitempty"`
+	To       time.Time `url:"date.to,omitempty"`
+	OrderID  int64     `url:"orderId,omitempty"`
+	Category string    `url:"category,omitempty"`
+}
+
+// Bills returns the identifiers of the bills of the account. A nil filter
+// returns all of them.
+func (client *Client) Bills(ctx context.Context, filter *BillFilter) ([]string, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	if err := client.api.GetWithContext(ctx, "/me/bill", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Bill returns the details of a bill.
+func (client *Client) Bill(ctx context.Context, billID string) (*Bill, error) {
+	bill := &Bill{}
+	if err := client.api.GetWithContext(ctx, billPath(billID), bill); err != nil {
+		return nil, err
+	}
+	return bill, nil
+}
+
+// BillDetails returns the identifiers of the lines of a bill.
+func (client *Client) BillDetails(ctx context.Context, billID string) ([]string, error) {
+	var ids []string
+	if err := client.api.GetWithContext(ctx, billPath(billID)+"/details", &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// BillDetail returns a line of a bill.
+func (client *Client) BillDetail(ctx context.Context, billID, billDetailID string) (*BillDetail, error) {
+	detail := &BillDetail{}
+	if err := client.api.GetWithContext(ctx, billPath(billID)+"/details/"+url.PathEscape(billDetailID), detail); err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+// DownloadBill writes the PDF document of a bill to w. The document is
+// streamed, not held in memory.
+func (client *Client) DownloadBill(ctx context.Context, billID string, w io.Writer) error {
+	bill, err := client.Bill(ctx, billID)
+	if err != nil {
+		return err
+	}
+	if bill.PDFURL == "" {
+		return fmt.Errorf("Bill %s has no PDF document", billID)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, "GET", bill.PDFURL, nil)
+	if err != nil {
+		return err
+	}
+	response, err := client.httpClient().Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("Downloading bill %s: unexpected status %s", billID, response.Status)
+	}
+	_, err = io.Copy(w, response.Body)
+	return err
+}
+
+// httpClient returns the HTTP client of the caller, if any, to transfer
+// documents served outside the API.
+func (client *Client) httpClient() *http.Client {
+	if caller, ok := client.api.(*govh.Caller); ok && caller.HTTPClient != nil {
+		return caller.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func billPath(billID string) string {
+	return "/me/bill/" + url.PathEscape(billID)
+}
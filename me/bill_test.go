@@ -0,0 +1,78 @@
+package me
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestBills(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /me/bill", 200, []string{"FR123456"})
+	server.Handle("GET /me/bill/FR123456", 200, map[string]interface{}{
+		"billId":          "FR123456",
+		"date":            "2024-03-01T10:00:00+01:00",
+		"orderId":         98765,
+		"category":        "autorenew",
+		"priceWithTax":    map[string]interface{}{"currencyCode": "EUR", "value": 12.0, "text": "12.00 €"},
+		"priceWithoutTax": map[string]interface{}{"currencyCode": "EUR", "value": 10.0, "text": "10.00 €"},
+		"tax":             map[string]interface{}{"currencyCode": "EUR", "value": 2.0, "text": "2.00 €"},
+		"pdfUrl":          server.URL + "/cgi-bin/bill.pdf?reference=FR123456",
+		"password":        "secret",
+	})
+	server.Handle("GET /me/bill/FR123456/details", 200, []string{"FR123456-1"})
+	server.Handle("GET /me/bill/FR123456/details/FR123456-1", 200, map[string]interface{}{
+		"billDetailId": "FR123456-1",
+		"description":  "Domain renewal",
+		"domain":       "example.com",
+		"periodStart":  "2024-03-01",
+		"periodEnd":    "2025-02-28",
+		"quantity":     "1",
+		"unitPrice":    map[string]interface{}{"currencyCode": "EUR", "value": 10.0, "text": "10.00 €"},
+		"totalPrice":   map[string]interface{}{"currencyCode": "EUR", "value": 10.0, "text": "10.00 €"},
+	})
+	server.HandleFunc("GET /cgi-bin/bill.pdf", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("%PDF-1.4"))
+	})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ids, err := client.Bills(ctx, &BillFilter{From: from, Category: "autorenew"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query := server.LastRequest().Query; len(ids) != 1 || query.Get("date.from") != "2024-01-01T00:00:00Z" || query.Get("category") != "autorenew" {
+		t.Fatalf("unexpected bills %v for query %v", ids, query)
+	}
+
+	bill, err := client.Bill(ctx, "FR123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bill.OrderID != 98765 || bill.PriceWithTax.String() != "12.00 EUR" || bill.Date.Day() != 1 {
+		t.Fatalf("unexpected bill %+v", bill)
+	}
+
+	detail, err := client.BillDetail(ctx, "FR123456", "FR123456-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detail.Domain != "example.com" || detail.PeriodEnd.String() != "2025-02-28" || detail.TotalPrice.String() != "10.00 EUR" {
+		t.Fatalf("unexpected bill detail %+v", detail)
+	}
+
+	var pdf bytes.Buffer
+	if err := client.DownloadBill(ctx, "FR123456", &pdf); err != nil {
+		t.Fatal(err)
+	}
+	if pdf.String() != "%PDF-1.4" {
+		t.Fatalf("unexpected document %q", pdf.String())
+	}
+}
@@ -0,0 +1,150 @@
+package me
+
+import (
+	"context"
+	"fmt"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Contact is a contact of the account, which can be set as the owner,
+// administrator, technical or billing contact of services.
+type Contact struct {
+	ID               int64          `json:"id,omitempty"`
+	LegalForm        string         `json:"legalForm,omitempty"`
+	FirstName        string         `json:"firstName,omitempty"`
+	LastName         string         `json:"lastName,omitempty"`
+	OrganisationName string         `json:"organisationName,omitempty"`
+	OrganisationType string         `json:"organisationType,omitempty"`
+	Email            string         `json:"email,omitempty"`
+	Phone            string         `json:"phone,omitempty"`
+	CellPhone        string         `json:"cellPhone,omitempty"`
+	Fax              string         `json:"fax,omitempty"`
+	Language         string         `json:"language,omitempty"`
+	Nationality      string         `json:"nationality,omitempty"`
+	VAT              string         `json:"vat,omitempty"`
+	Address          ContactAddress `json:"address,omitzero"`
+}
+
+// ContactAddress is the postal address of a contact.
+type ContactAddress struct {
+	Line1    string `json:"line1,omitempty"`
+	Line2    string `json:"line2,omitempty"`
+	Line3    string `json:"line3,omitempty"`
+	Zip      string `json:"zip,omitempty"`
+	City     string `json:"city,omitempty"`
+	Province string `json:"province,omitempty"`
+	Country  string `json:"country,omitempty"`
+}
+
+// ContactChange is a request to change the contacts of a service, which must
+// be accepted by both the current and the new contacts.
+type ContactChange struct {
+	ID            int64  `json:"id"`
+	ServiceDomain string `json:"serviceDomain"`
+	AskingAccount string `json:"askingAccount"`
+	FromAccount   string `json:"fromAccount"`
+	ToAccount     string `json:"toAccount"`
+	// Contacts to change: contactAdmin, contactBilling or contactTech.
+	ContactTypes []string `json:"contactTypes"`
+	// State of the change: aborted, checkValidity, doing, done, error,
+	// expired, refused, todo or validatingByCustomers.
+	State       string        `json:"state"`
+	DateRequest govh.DateTime `json:"dateRequest"`
+	DateDone    govh.DateTime `json:"dateDone"`
+}
+
+// ContactChangeFilter filters the changes returned by ContactChanges.
+type ContactChangeFilter struct {
+	AskingAccount string `url:"askingAccount,omitempty"`
+	ToAccount     string `url:"toAccount,omitempty"`
+	State         string `url:"state,omitempty"`
+}
+
+// Contacts returns the identifiers of the contacts of the account.
+func (client *Client) Contacts(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, "/me/contact", &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Contact returns the details of a contact.
+func (client *Client) Contact(ctx context.Context, contactID int64) (*Contact, error) {
+	contact := &Contact{}
+	if err := client.api.GetWithContext(ctx, contactPath(contactID), contact); err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
+
+// CreateContact creates a contact, and returns it with its identifier.
+// Contacts can't be deleted.
+func (client *Client) CreateContact(ctx context.Context, contact *Contact) (*Contact, error) {
+	created := &Contact{}
+	if err := client.api.PostWithContext(ctx, "/me/contact", contact, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateContact modifies a contact with the non-empty fields of contact.
+func (client *Client) UpdateContact(ctx context.Context, contactID int64, contact *Contact) (*Contact, error) {
+	updated := &Contact{}
+	if err := client.api.PutWithContext(ctx, contactPath(contactID), contact, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// ContactChanges returns the identifiers of the contact changes involving
+// the account. A nil filter returns all of them.
+func (client *Client) ContactChanges(ctx context.Context, filter *ContactChangeFilter) ([]int64, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, "/me/task/contactChange", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ContactChange returns the details of a contact change.
+func (client *Client) ContactChange(ctx context.Context, id int64) (*ContactChange, error) {
+	change := &ContactChange{}
+	if err := client.api.GetWithContext(ctx, contactChangePath(id), change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// AcceptContactChange accepts a contact change, with the token received by
+// email.
+func (client *Client) AcceptContactChange(ctx context.Context, id int64, token string) error {
+	body := map[string]interface{}{"token": token}
+	return client.api.PostWithContext(ctx, contactChangePath(id)+"/accept", body, nil)
+}
+
+// RefuseContactChange refuses a contact change, with the token received by
+// email.
+func (client *Client) RefuseContactChange(ctx context.Context, id int64, token string) error {
+	body := map[string]interface{}{"token": token}
+	return client.api.PostWithContext(ctx, contactChangePath(id)+"/refuse", body, nil)
+}
+
+// ResendContactChangeEmail sends the emails of a contact change again.
+func (client *Client) ResendContactChangeEmail(ctx context.Context, id int64) error {
+	return client.api.PostWithContext(ctx, contactChangePath(id)+"/resendEmail", nil, nil)
+}
+
+func contactPath(contactID int64) string {
+	return fmt.Sprintf("/me/contact/%d", contactID)
+}
+
+func contactChangePath(id int64) string {
+	return fmt.Sprintf("/me/task/contactChange/%d", id)
+}
@@ -0,0 +1,90 @@
+package me
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestContacts(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("POST /me/contact", 200, map[string]interface{}{"id": 5, "firstName": "Jane", "lastName": "Doe", "address": map[string]interface{}{"city": "Lille", "country": "FR"}})
+	server.Handle("GET /me/contact/5", 200, map[string]interface{}{"id": 5, "firstName": "Jane", "lastName": "Doe", "address": map[string]interface{}{"city": "Lille", "country": "FR"}})
+	server.Handle("PUT /me/contact/5", 200, map[string]interface{}{"id": 5, "firstName": "Jane", "lastName": "Smith"})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	contact, err := client.CreateContact(ctx, &Contact{FirstName: "Jane", LastName: "Doe", LegalForm: "individual", Address: ContactAddress{City: "Lille", Country: "FR"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"legalForm":"individual","firstName":"Jane","lastName":"Doe","address":{"city":"Lille","country":"FR"}}`
+	if body := string(server.LastRequest().Body); contact.ID != 5 || body != expected {
+		t.Fatalf("unexpected contact %+v created with %s", contact, body)
+	}
+
+	contact, err = client.Contact(ctx, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contact.Address.City != "Lille" {
+		t.Fatalf("unexpected contact %+v", contact)
+	}
+
+	contact, err = client.UpdateContact(ctx, 5, &Contact{LastName: "Smith"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contact.LastName != "Smith" {
+		t.Fatalf("unexpected contact %+v", contact)
+	}
+}
+
+func TestContactChanges(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /me/task/contactChange", 200, []int64{9})
+	server.Handle("GET /me/task/contactChange/9", 200, map[string]interface{}{
+		"id":            9,
+		"serviceDomain": "example.com",
+		"fromAccount":   "xx1234-ovh",
+		"toAccount":     "yy5678-ovh",
+		"contactTypes":  []string{"contactAdmin"},
+		"state":         "validatingByCustomers",
+		"dateRequest":   "2024-03-01T10:00:00+01:00",
+	})
+	server.Handle("POST /me/task/contactChange/9/accept", 200, nil)
+	server.Handle("POST /me/task/contactChange/9/resendEmail", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	ids, err := client.ContactChanges(ctx, &ContactChangeFilter{State: "validatingByCustomers"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 || server.LastRequest().Query.Get("state") != "validatingByCustomers" {
+		t.Fatalf("unexpected contact changes %v", ids)
+	}
+
+	change, err := client.ContactChange(ctx, 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.ToAccount != "yy5678-ovh" || change.ContactTypes[0] != "contactAdmin" {
+		t.Fatalf("unexpected contact change %+v", change)
+	}
+
+	if err := client.AcceptContactChange(ctx, 9, "tok3n"); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"token":"tok3n"}` {
+		t.Fatalf("unexpected acceptance %s", body)
+	}
+	if err := client.ResendContactChangeEmail(ctx, 9); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,205 @@
+package me
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Document is a file stored on the account, such as an identity document
+// requested for a verification.
+type Document struct {
+	ID             string        `json:"id"`
+	Name           string        `json:"name"`
+	Size           int64         `json:"size"`
+	CreationDate   govh.DateTime `json:"creationDate"`
+	ExpirationDate govh.DateTime `json:"expirationDate"`
+	ValidationDate govh.DateTime `json:"validationDate"`
+	Tags           []DocumentTag `json:"tags"`
+	// URLs to download, and to upload the content of the document. They can
+	// be used without authentication.
+	GetURL string `json:"getUrl"`
+	PutURL string `json:"putUrl"`
+}
+
+// DocumentTag is a key/value pair set on a document.
+type DocumentTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Documents returns the identifiers of the documents of the account.
+func (client *Client) Documents(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := client.api.GetWithContext(ctx, "/me/document", &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Document returns the details of a document.
+func (client *Client) Document(ctx context.Context, documentID string) (*Document, error) {
+	document := &Document{}
+	if err := client.api.GetWithContext(ctx, documentPath(documentID), document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// CreateDocument creates an empty document, whose content is then uploaded
+// to its PutURL. See UploadDocument.
+func (client *Client) CreateDocument(ctx context.Context, name string, tags []DocumentTag) (*Document, error) {
+	body := map[string]interface{}{"name": name}
+	if len(tags) > 0 {
+		body["tags"] = tags
+	}
+
+	document := &Document{}
+	if err := client.api.PostWithContext(ctx, "/me/document", body, document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// DeleteDocument removes a document.
+func (client *Client) DeleteDocument(ctx context.Context, documentID string) error {
+	return client.api.DeleteWithContext(ctx, documentPath(documentID), nil)
+}
+
+// TagDocument sets a tag on a document.
+func (client *Client) TagDocument(ctx context.Context, documentID, key, value string) error {
+	body := map[string]interface{}{"key": key, "value": value}
+	return client.api.PostWithContext(ctx, documentPath(documentID)+"/tag", body, nil)
+}
+
+// UntagDocument removes a tag from a document.
+func (client *Client) UntagDocument(ctx context.Context, documentID, key string) error {
+	return client.api.DeleteWithContext(ctx, documentPath(documentID)+"/tag/"+url.PathEscape(key), nil)
+}
+
+// UploadDocument creates a document, and uploads its content, read from
+// content. The upload URLs reject chunked uploads, so content is read in
+// memory first to send its size: use UploadDocumentFile for large files.
+// If the upload fails, the document is deleted, or returned along with the
+// error when it can't be.
+func (client *Client) UploadDocument(ctx context.Context, name string, content io.Reader, tags []DocumentTag) (*Document, error) {
+	data, err := ioutil.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+	return client.uploadDocument(ctx, name, bytes.NewReader(data), int64(len(data)), tags)
+}
+
+// UploadDocumentFile creates a document named after a local file, and
+// uploads the file. Failed uploads are handled as by UploadDocument.
+func (client *Client) UploadDocumentFile(ctx context.Context, path string, tags []DocumentTag) (*Document, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return client.uploadDocument(ctx, filepath.Base(path), file, info.Size(), tags)
+}
+
+// uploadDocument creates a document and uploads size bytes of content. If
+// the upload fails, the document is deleted; if it can't be, it is
+// returned along with the error, to be deleted later.
+func (client *Client) uploadDocument(ctx context.Context, name string, content io.Reader, size int64, tags []DocumentTag) (*Document, error) {
+	document, err := client.CreateDocument(ctx, name, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Dry-run callers create no document, and have nothing to upload to
+	if client.dryRun() {
+		return document, nil
+	}
+	if document.PutURL == "" {
+		err = fmt.Errorf("Document %s has no upload URL", document.ID)
+	} else {
+		err = client.putDocument(ctx, document, content, size)
+	}
+
+	if err != nil {
+		if document.ID == "" {
+			return nil, err
+		}
+		if deleteErr := client.DeleteDocument(ctx, document.ID); deleteErr != nil {
+			return document, fmt.Errorf("%s, and deleting document %s: %s", err, document.ID, deleteErr)
+		}
+		return nil, err
+	}
+	return document, nil
+}
+
+// putDocument uploads the content of a document to its PutURL.
+func (client *Client) putDocument(ctx context.Context, document *Document, content io.Reader, size int64) error {
+	request, err := http.NewRequestWithContext(ctx, "PUT", document.PutURL, content)
+	if err != nil {
+		return err
+	}
+	request.ContentLength = size
+
+	response, err := client.httpClient().Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("Uploading document %s: unexpected status %s", document.ID, response.Status)
+	}
+	return nil
+}
+
+// DownloadDocument writes the content of a document to w. The content is
+// streamed, not held in memory.
+func (client *Client) DownloadDocument(ctx context.Context, documentID string, w io.Writer) error {
+	document, err := client.Document(ctx, documentID)
+	if err != nil {
+		return err
+	}
+	if document.GetURL == "" {
+		return fmt.Errorf("Document %s has no download URL", documentID)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, "GET", document.GetURL, nil)
+	if err != nil {
+		return err
+	}
+	response, err := client.httpClient().Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("Downloading document %s: unexpected status %s", documentID, response.Status)
+	}
+	_, err = io.Copy(w, response.Body)
+	return err
+}
+
+// dryRun tells whether the caller intercepts the calls modifying resources.
+func (client *Client) dryRun() bool {
+	caller, ok := client.api.(*govh.Caller)
+	return ok && caller.DryRun
+}
+
+func documentPath(documentID string) string {
+	return "/me/document/" + url.PathEscape(documentID)
+}
@@ -0,0 +1,142 @@
+package me
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestUploadDocumentFile(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("POST /me/document", 200, map[string]interface{}{
+		"id":     "doc-1",
+		"name":   "id.pdf",
+		"getUrl": server.URL + "/storage/doc-1",
+		"putUrl": server.URL + "/storage/doc-1?signature=s",
+	})
+	server.Handle("POST /me/document/doc-1/tag", 200, nil)
+
+	var uploaded []byte
+	var length int64
+	server.HandleFunc("PUT /storage/doc-1", func(w http.ResponseWriter, r *http.Request) {
+		uploaded, _ = ioutil.ReadAll(r.Body)
+		length = r.ContentLength
+	})
+
+	path := filepath.Join(t.TempDir(), "id.pdf")
+	if err := ioutil.WriteFile(path, []byte("%PDF-1.4 identity"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	document, err := client.UploadDocumentFile(ctx, path, []DocumentTag{{Key: "usage", Value: "kyc"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if document.ID != "doc-1" || string(uploaded) != "%PDF-1.4 identity" || length != 17 {
+		t.Fatalf("unexpected upload of %q (%d bytes) for %+v", uploaded, length, document)
+	}
+
+	creation := server.Requests()[len(server.Requests())-2]
+	if string(creation.Body) != `{"name":"id.pdf","tags":[{"key":"usage","value":"kyc"}]}` {
+		t.Fatalf("unexpected creation %s", creation.Body)
+	}
+
+	if err := client.TagDocument(ctx, "doc-1", "case", "1234"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUploadDocument(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("POST /me/document", 200, map[string]interface{}{
+		"id":     "doc-2",
+		"name":   "notes.txt",
+		"putUrl": server.URL + "/storage/doc-2?signature=s",
+	})
+	server.Handle("DELETE /me/document/doc-2", 200, nil)
+
+	var length int64
+	var encoding []string
+	status := http.StatusOK
+	server.HandleFunc("PUT /storage/doc-2", func(w http.ResponseWriter, r *http.Request) {
+		length, encoding = r.ContentLength, r.TransferEncoding
+		ioutil.ReadAll(r.Body)
+		w.WriteHeader(status)
+	})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	document, err := client.UploadDocument(ctx, "notes.txt", strings.NewReader("some notes"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if document.ID != "doc-2" || length != 10 || len(encoding) != 0 {
+		t.Fatalf("unexpected upload of %d bytes, encoding %v", length, encoding)
+	}
+
+	status = http.StatusForbidden
+	document, err = client.UploadDocument(ctx, "notes.txt", strings.NewReader("some notes"), nil)
+	if err == nil || document != nil {
+		t.Fatalf("expected the upload to fail, got %+v, %v", document, err)
+	}
+	if request := server.LastRequest(); request.Method != "DELETE" || request.Path != "/me/document/doc-2" {
+		t.Fatalf("expected the document to be deleted, got %s %s", request.Method, request.Path)
+	}
+
+	server.HandleError("DELETE /me/document/doc-2", 500, "", "Internal server error")
+	document, err = client.UploadDocument(ctx, "notes.txt", strings.NewReader("some notes"), nil)
+	if err == nil || document == nil || document.ID != "doc-2" {
+		t.Fatalf("expected the document to be returned with the error, got %+v, %v", document, err)
+	}
+}
+
+func TestUploadDocumentDryRun(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+
+	caller := server.Caller()
+	caller.DryRun = true
+	document, err := New(caller).UploadDocument(context.Background(), "notes.txt", strings.NewReader("some notes"), nil)
+	if err != nil || document == nil {
+		t.Fatalf("expected a synthetic document, got %+v, %v", document, err)
+	}
+	if requests := server.Requests(); len(requests) != 0 {
+		t.Fatalf("expected no request, got %s %s", requests[0].Method, requests[0].Path)
+	}
+}
+
+func TestDownloadDocument(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /me/document/doc-1", 200, map[string]interface{}{"id": "doc-1", "getUrl": server.URL + "/storage/doc-1?signature=s"})
+	server.Handle("GET /me/document/doc-2", 200, map[string]interface{}{"id": "doc-2"})
+	server.HandleFunc("GET /storage/doc-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("%PDF-1.4 identity"))
+	})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	var content bytes.Buffer
+	if err := client.DownloadDocument(ctx, "doc-1", &content); err != nil {
+		t.Fatal(err)
+	}
+	if content.String() != "%PDF-1.4 identity" {
+		t.Fatalf("unexpected content %q", content.String())
+	}
+	if err := client.DownloadDocument(ctx, "doc-2", &content); err == nil {
+		t.Fatal("expected an error for a document without download URL")
+	}
+}
@@ -0,0 +1,167 @@
+package me
+
+import (
+	"context"
+	"net/url"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Partition types.
+const (
+	PartitionPrimary = "primary"
+	PartitionLogical = "logical"
+	PartitionLV      = "lv"
+)
+
+// InstallationTemplate is an OS installation template of the account, based
+// on a template of OVH, which dedicated servers can be installed with.
+type InstallationTemplate struct {
+	TemplateName     string `json:"templateName"`
+	BaseTemplateName string `json:"baseTemplateName"`
+	Description      string `json:"description"`
+	Distribution     string `json:"distribution"`
+	Family           string `json:"family"`
+	// BitFormat is 32 or 64.
+	BitFormat          int                    `json:"bitFormat"`
+	DefaultLanguage    string                 `json:"defaultLanguage"`
+	AvailableLanguages []string               `json:"availableLanguages"`
+	Customization      *TemplateCustomization `json:"customization"`
+	LastModification   govh.DateTime          `json:"lastModification"`
+}
+
+// TemplateCustomization are the settings of an installation template applied
+// to the installed servers.
+type TemplateCustomization struct {
+	CustomHostname string `json:"customHostname,omitempty"`
+	// URL of a script run once the installation is done, and the output
+	// it must return for the installation to succeed.
+	PostInstallationScriptLink   string `json:"postInstallationScriptLink,omitempty"`
+	PostInstallationScriptReturn string `json:"postInstallationScriptReturn,omitempty"`
+	// Name of an SSH key of the account to install, see SSHKeys.
+	SSHKeyName            string `json:"sshKeyName,omitempty"`
+	UseDistributionKernel bool   `json:"useDistributionKernel,omitempty"`
+}
+
+// PartitionScheme is a partitioning of the disks of a server, among which
+// the one with the highest priority is used by default.
+type PartitionScheme struct {
+	Name     string `json:"name"`
+	Priority int    `json:"priority"`
+}
+
+// Partition is a partition of a partition scheme.
+type Partition struct {
+	Mountpoint string `json:"mountpoint"`
+	Filesystem string `json:"filesystem"`
+	// Type is one of the Partition constants.
+	Type string `json:"type"`
+	// RAID level, such as 1, for servers with several disks.
+	RAID       string `json:"raid,omitempty"`
+	VolumeName string `json:"volumeName,omitempty"`
+	// Size in MB, 0 to fill the remaining space.
+	Size int64 `json:"size"`
+	// Step orders the partitions on the disks.
+	Step int `json:"step"`
+}
+
+// InstallationTemplates returns the names of the installation templates of
+// the account.
+func (client *Client) InstallationTemplates(ctx context.Context) ([]string, error) {
+	var names []string
+	if err := client.api.GetWithContext(ctx, "/me/installationTemplate", &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// InstallationTemplate returns an installation template of the account.
+func (client *Client) InstallationTemplate(ctx context.Context, name string) (*InstallationTemplate, error) {
+	template := &InstallationTemplate{}
+	if err := client.api.GetWithContext(ctx, installationTemplatePath(name), template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// CreateInstallationTemplate creates an installation template from a
+// template of OVH, such as debian12_64. It has the partition schemes of
+// its base template, which can then be changed.
+func (client *Client) CreateInstallationTemplate(ctx context.Context, baseTemplateName, name, defaultLanguage string) error {
+	body := map[string]interface{}{"baseTemplateName": baseTemplateName, "name": name, "defaultLanguage": defaultLanguage}
+	return client.api.PostWithContext(ctx, "/me/installationTemplate", body, nil)
+}
+
+// UpdateInstallationTemplate modifies the name, default language and
+// customization of an installation template.
+func (client *Client) UpdateInstallationTemplate(ctx context.Context, name string, template *InstallationTemplate) error {
+	body := map[string]interface{}{"templateName": template.TemplateName, "defaultLanguage": template.DefaultLanguage}
+	if template.Customization != nil {
+		body["customization"] = template.Customization
+	}
+	return client.api.PutWithContext(ctx, installationTemplatePath(name), body, nil)
+}
+
+// DeleteInstallationTemplate removes an installation template.
+func (client *Client) DeleteInstallationTemplate(ctx context.Context, name string) error {
+	return client.api.DeleteWithContext(ctx, installationTemplatePath(name), nil)
+}
+
+// PartitionSchemes returns the names of the partition schemes of an
+// installation template.
+func (client *Client) PartitionSchemes(ctx context.Context, templateName string) ([]string, error) {
+	var names []string
+	if err := client.api.GetWithContext(ctx, installationTemplatePath(templateName)+"/partitionScheme", &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// PartitionScheme returns a partition scheme of an installation template.
+func (client *Client) PartitionScheme(ctx context.Context, templateName, schemeName string) (*PartitionScheme, error) {
+	scheme := &PartitionScheme{}
+	if err := client.api.GetWithContext(ctx, partitionSchemePath(templateName, schemeName), scheme); err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// CreatePartitionScheme adds an empty partition scheme to an installation
+// template.
+func (client *Client) CreatePartitionScheme(ctx context.Context, templateName string, scheme *PartitionScheme) error {
+	return client.api.PostWithContext(ctx, installationTemplatePath(templateName)+"/partitionScheme", scheme, nil)
+}
+
+// DeletePartitionScheme removes a partition scheme from an installation
+// template.
+func (client *Client) DeletePartitionScheme(ctx context.Context, templateName, schemeName string) error {
+	return client.api.DeleteWithContext(ctx, partitionSchemePath(templateName, schemeName), nil)
+}
+
+// Partitions returns the mount points of the partitions of a partition
+// scheme.
+func (client *Client) Partitions(ctx context.Context, templateName, schemeName string) ([]string, error) {
+	var mountpoints []string
+	if err := client.api.GetWithContext(ctx, partitionSchemePath(templateName, schemeName)+"/partition", &mountpoints); err != nil {
+		return nil, err
+	}
+	return mountpoints, nil
+}
+
+// CreatePartition adds a partition to a partition scheme.
+func (client *Client) CreatePartition(ctx context.Context, templateName, schemeName string, partition *Partition) error {
+	return client.api.PostWithContext(ctx, partitionSchemePath(templateName, schemeName)+"/partition", partition, nil)
+}
+
+// DeletePartition removes a partition from a partition scheme.
+func (client *Client) DeletePartition(ctx context.Context, templateName, schemeName, mountpoint string) error {
+	return client.api.DeleteWithContext(ctx, partitionSchemePath(templateName, schemeName)+"/partition/"+url.PathEscape(mountpoint), nil)
+}
+
+func installationTemplatePath(name string) string {
+	return "/me/installationTemplate/" + url.PathEscape(name)
+}
+
+func partitionSchemePath(templateName, schemeName string) string {
+	return installationTemplatePath(templateName) + "/partitionScheme/" + url.PathEscape(schemeName)
+}
@@ -0,0 +1,122 @@
+package me
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestInstallationTemplates(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /me/installationTemplate", 200, []string{"debian-web"})
+	server.Handle("GET /me/installationTemplate/debian-web", 200, map[string]interface{}{
+		"templateName":       "debian-web",
+		"baseTemplateName":   "debian12_64",
+		"distribution":       "debian",
+		"family":             "linux",
+		"bitFormat":          64,
+		"defaultLanguage":    "en",
+		"availableLanguages": []string{"en", "fr"},
+		"customization":      map[string]interface{}{"sshKeyName": "deploy", "postInstallationScriptLink": "https://example.com/setup.sh"},
+		"lastModification":   "2024-03-01T10:00:00+01:00",
+	})
+	server.Handle("POST /me/installationTemplate", 200, nil)
+	server.Handle("PUT /me/installationTemplate/debian-web", 200, nil)
+	server.Handle("DELETE /me/installationTemplate/debian-web", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	names, err := client.InstallationTemplates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 {
+		t.Fatalf("unexpected templates %v", names)
+	}
+	template, err := client.InstallationTemplate(ctx, names[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if template.BaseTemplateName != "debian12_64" || template.BitFormat != 64 || template.Customization.SSHKeyName != "deploy" {
+		t.Fatalf("unexpected template %+v", template)
+	}
+
+	if err := client.CreateInstallationTemplate(ctx, "debian12_64", "debian-web", "en"); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"baseTemplateName":"debian12_64","defaultLanguage":"en","name":"debian-web"}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+
+	template.Customization = &TemplateCustomization{CustomHostname: "web1.example.com"}
+	if err := client.UpdateInstallationTemplate(ctx, "debian-web", template); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"customization":{"customHostname":"web1.example.com"},"defaultLanguage":"en","templateName":"debian-web"}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+
+	if err := client.DeleteInstallationTemplate(ctx, "debian-web"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPartitionSchemes(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /me/installationTemplate/debian-web/partitionScheme", 200, []string{"default"})
+	server.Handle("GET /me/installationTemplate/debian-web/partitionScheme/default", 200, map[string]interface{}{"name": "default", "priority": 1})
+	server.Handle("POST /me/installationTemplate/debian-web/partitionScheme", 200, nil)
+	server.Handle("DELETE /me/installationTemplate/debian-web/partitionScheme/raid", 200, nil)
+	server.Handle("GET /me/installationTemplate/debian-web/partitionScheme/raid/partition", 200, []string{"/", "swap"})
+	server.Handle("POST /me/installationTemplate/debian-web/partitionScheme/raid/partition", 200, nil)
+	server.Handle("DELETE /me/installationTemplate/debian-web/partitionScheme/raid/partition/{mountpoint}", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	names, err := client.PartitionSchemes(ctx, "debian-web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	scheme, err := client.PartitionScheme(ctx, "debian-web", names[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scheme.Name != "default" || scheme.Priority != 1 {
+		t.Fatalf("unexpected partition scheme %+v", scheme)
+	}
+
+	if err := client.CreatePartitionScheme(ctx, "debian-web", &PartitionScheme{Name: "raid", Priority: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"name":"raid","priority":2}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+
+	mountpoints, err := client.Partitions(ctx, "debian-web", "raid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mountpoints) != 2 {
+		t.Fatalf("unexpected partitions %v", mountpoints)
+	}
+
+	partition := &Partition{Mountpoint: "/var", Filesystem: "ext4", Type: PartitionPrimary, RAID: "1", Size: 20480, Step: 3}
+	if err := client.CreatePartition(ctx, "debian-web", "raid", partition); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"mountpoint":"/var","filesystem":"ext4","type":"primary","raid":"1","size":20480,"step":3}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+
+	if err := client.DeletePartition(ctx, "debian-web", "raid", "swap"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.DeletePartitionScheme(ctx, "debian-web", "raid"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,15 @@
+// Package me wraps the /me routes of the OVH API, which manage the account of
+// the authenticated user.
+package me
+
+import govh "github.com/garbage-collector/ovh-go"
+
+// Client calls the /me routes.
+type Client struct {
+	api govh.Client
+}
+
+// New returns a Client calling the API with client, usually a *govh.Caller.
+func New(client govh.Client) *Client {
+	return &Client{api: client}
+}
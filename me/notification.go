@@ -0,0 +1,36 @@
+package me
+
+import (
+	"context"
+	"fmt"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// NotificationEmail is an email sent by OVH to the account, such as an
+// incident notice or an expiration warning.
+type NotificationEmail struct {
+	ID      int64         `json:"id"`
+	Date    govh.DateTime `json:"date"`
+	Subject string        `json:"subject"`
+	Body    string        `json:"body"`
+}
+
+// NotificationEmails returns the identifiers of the emails sent to the
+// account.
+func (client *Client) NotificationEmails(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, "/me/notification/email/history", &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// NotificationEmail returns an email sent to the account.
+func (client *Client) NotificationEmail(ctx context.Context, id int64) (*NotificationEmail, error) {
+	email := &NotificationEmail{}
+	if err := client.api.GetWithContext(ctx, fmt.Sprintf("/me/notification/email/history/%d", id), email); err != nil {
+		return nil, err
+	}
+	return email, nil
+}
@@ -0,0 +1,39 @@
+package me
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestNotificationEmails(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /me/notification/email/history", 200, []int64{101, 102})
+	server.Handle("GET /me/notification/email/history/102", 200, map[string]interface{}{
+		"id":      102,
+		"date":    "2024-03-01T10:00:00+01:00",
+		"subject": "Your domain example.com expires soon",
+		"body":    "Hello,\n...",
+	})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	ids, err := client.NotificationEmails(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("unexpected emails %v", ids)
+	}
+
+	email, err := client.NotificationEmail(ctx, ids[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if email.Subject != "Your domain example.com expires soon" || email.Date.Month() != 3 {
+		t.Fatalf("unexpected email %+v", email)
+	}
+}
@@ -0,0 +1,141 @@
+package me
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Order is an order of the account, paid or not.
+type Order struct {
+	OrderID         int64         `json:"orderId"`
+	Date            govh.DateTime `json:"date"`
+	ExpirationDate  govh.DateTime `json:"expirationDate"`
+	RetractionDate  govh.DateTime `json:"retractionDate"`
+	PriceWithTax    govh.Price    `json:"priceWithTax"`
+	PriceWithoutTax govh.Price    `json:"priceWithoutTax"`
+	Tax             govh.Price    `json:"tax"`
+	// URL of the order as a web page, and as a PDF document.
+	URL      string `json:"url"`
+	PDFURL   string `json:"pdfUrl"`
+	Password string `json:"password"`
+}
+
+// OrderDetail is a line of an order.
+type OrderDetail struct {
+	OrderDetailID int64  `json:"orderDetailId"`
+	Description   string `json:"description"`
+	Domain        string `json:"domain"`
+	// Type of the line: ACCESSORY, CONSUMPTION, CREATION, DELIVERY,
+	// DURATION, GIFT, INSTALLATION, LICENSE, MUTE, OTHER, QUANTITY,
+	// REFUND, RENEW, SPECIAL, SWITCH, TRANSFER or VOUCHER.
+	DetailType string     `json:"detailType"`
+	Quantity   string     `json:"quantity"`
+	UnitPrice  govh.Price `json:"unitPrice"`
+	TotalPrice govh.Price `json:"totalPrice"`
+}
+
+// Order statuses, as returned by OrderStatus.
+const (
+	OrderStatusCancelled          = "cancelled"
+	OrderStatusCancelling         = "cancelling"
+	OrderStatusChecking           = "checking"
+	OrderStatusDelivered          = "delivered"
+	OrderStatusDelivering         = "delivering"
+	OrderStatusDocumentsRequested = "documentsRequested"
+	OrderStatusNotPaid            = "notPaid"
+	OrderStatusRefunded           = "refunded"
+	OrderStatusRefunding          = "refunding"
+	OrderStatusUnknown            = "unknown"
+)
+
+// OrderFilter filters the orders returned by Orders.
+type OrderFilter struct {
+	From time.Time `url:"date.from,omitempty"`
+	To   time.Time `url:"date.to,omitempty"`
+}
+
+// Orders returns the identifiers of the orders of the account. A nil filter
+// returns all of them.
+func (client *Client) Orders(ctx context.Context, filter *OrderFilter) ([]int64, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, "/me/order", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Order returns the details of an order.
+func (client *Client) Order(ctx context.Context, orderID int64) (*Order, error) {
+	order := &Order{}
+	if err := client.api.GetWithContext(ctx, orderPath(orderID), order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// OrderDetails returns the identifiers of the lines of an order.
+func (client *Client) OrderDetails(ctx context.Context, orderID int64) ([]int64, error) {
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, orderPath(orderID)+"/details", &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// OrderDetail returns a line of an order.
+func (client *Client) OrderDetail(ctx context.Context, orderID, orderDetailID int64) (*OrderDetail, error) {
+	detail := &OrderDetail{}
+	if err := client.api.GetWithContext(ctx, fmt.Sprintf("%s/details/%d", orderPath(orderID), orderDetailID), detail); err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+// OrderStatus returns the status of an order, one of the OrderStatus
+// constants.
+func (client *Client) OrderStatus(ctx context.Context, orderID int64) (string, error) {
+	var status string
+	if err := client.api.GetWithContext(ctx, orderPath(orderID)+"/status", &status); err != nil {
+		return "", err
+	}
+	return status, nil
+}
+
+// AvailablePaymentMeanTypes returns the types of the registered payment means
+// which can pay an order.
+func (client *Client) AvailablePaymentMeanTypes(ctx context.Context, orderID int64) ([]PaymentMeanType, error) {
+	var available []struct {
+		PaymentMean PaymentMeanType `json:"paymentMean"`
+	}
+	if err := client.api.GetWithContext(ctx, orderPath(orderID)+"/availableRegisteredPaymentMean", &available); err != nil {
+		return nil, err
+	}
+
+	types := make([]PaymentMeanType, len(available))
+	for i, mean := range available {
+		types[i] = mean.PaymentMean
+	}
+	return types, nil
+}
+
+// PayOrder pays an order with a registered payment mean. A zero
+// paymentMeanID pays with the default payment mean of the given type.
+func (client *Client) PayOrder(ctx context.Context, orderID int64, paymentMeanType PaymentMeanType, paymentMeanID int64) error {
+	body := map[string]interface{}{"paymentMean": paymentMeanType}
+	if paymentMeanID != 0 {
+		body["paymentMeanId"] = paymentMeanID
+	}
+	return client.api.PostWithContext(ctx, orderPath(orderID)+"/payWithRegisteredPaymentMean", body, nil)
+}
+
+func orderPath(orderID int64) string {
+	return fmt.Sprintf("/me/order/%d", orderID)
+}
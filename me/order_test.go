@@ -0,0 +1,76 @@
+package me
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestOrders(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /me/order", 200, []int64{123})
+	server.Handle("GET /me/order/123", 200, map[string]interface{}{
+		"orderId":         123,
+		"date":            "2024-03-01T10:00:00+01:00",
+		"expirationDate":  "2024-03-15T10:00:00+01:00",
+		"priceWithTax":    map[string]interface{}{"currencyCode": "EUR", "value": 14.39, "text": "14.39 €"},
+		"priceWithoutTax": map[string]interface{}{"currencyCode": "EUR", "value": 11.99, "text": "11.99 €"},
+	})
+	server.Handle("GET /me/order/123/details", 200, []int64{456})
+	server.Handle("GET /me/order/123/details/456", 200, map[string]interface{}{"orderDetailId": 456, "domain": "example.com", "detailType": "DURATION", "quantity": "1"})
+	server.Handle("GET /me/order/123/status", 200, "notPaid")
+	server.Handle("GET /me/order/123/availableRegisteredPaymentMean", 200, []map[string]interface{}{{"paymentMean": "creditCard"}, {"paymentMean": "fidelityAccount"}})
+	server.Handle("POST /me/order/123/payWithRegisteredPaymentMean", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	ids, err := client.Orders(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 || ids[0] != 123 {
+		t.Fatalf("unexpected orders %v", ids)
+	}
+
+	order, err := client.Order(ctx, 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.PriceWithTax.String() != "14.39 EUR" || order.ExpirationDate.Day() != 15 {
+		t.Fatalf("unexpected order %+v", order)
+	}
+
+	detail, err := client.OrderDetail(ctx, 123, 456)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detail.Domain != "example.com" || detail.DetailType != "DURATION" {
+		t.Fatalf("unexpected order detail %+v", detail)
+	}
+
+	status, err := client.OrderStatus(ctx, 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != OrderStatusNotPaid {
+		t.Fatalf("unexpected status %q", status)
+	}
+
+	types, err := client.AvailablePaymentMeanTypes(ctx, 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(types) != 2 || types[0] != PaymentMeanCreditCard {
+		t.Fatalf("unexpected payment means %v", types)
+	}
+
+	if err := client.PayOrder(ctx, 123, PaymentMeanCreditCard, 8); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"paymentMean":"creditCard","paymentMeanId":8}` {
+		t.Fatalf("unexpected payment %s", body)
+	}
+}
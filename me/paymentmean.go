@@ -0,0 +1,122 @@
+package me
+
+import (
+	"context"
+	"fmt"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// PaymentMeanType is a kind of payment mean, naming its routes under
+// /me/paymentMean.
+type PaymentMeanType string
+
+// Payment mean types.
+const (
+	PaymentMeanCreditCard  PaymentMeanType = "creditCard"
+	PaymentMeanBankAccount PaymentMeanType = "bankAccount"
+	PaymentMeanPayPal      PaymentMeanType = "paypal"
+)
+
+// CreditCard is a credit card registered on the account.
+type CreditCard struct {
+	ID          int64  `json:"id"`
+	Description string `json:"description"`
+	// Masked number of the card.
+	Number         string        `json:"number"`
+	Type           string        `json:"type"`
+	ExpirationDate govh.DateTime `json:"expirationDate"`
+	// State of the card: valid, expired, tooManyFailures or pendingValidation.
+	State              string `json:"state"`
+	DefaultPaymentMean bool   `json:"defaultPaymentMean"`
+	ThreeDSValidated   bool   `json:"threeDSValidated"`
+}
+
+// BankAccount is a bank account registered on the account, for SEPA
+// direct debits.
+type BankAccount struct {
+	ID                     int64         `json:"id"`
+	Description            string        `json:"description"`
+	IBAN                   string        `json:"iban"`
+	BIC                    string        `json:"bic"`
+	OwnerName              string        `json:"ownerName"`
+	OwnerAddress           string        `json:"ownerAddress"`
+	UniqueReference        string        `json:"uniqueReference"`
+	CreationDate           govh.DateTime `json:"creationDate"`
+	MandateSignatureDate   govh.DateTime `json:"mandateSignatureDate"`
+	ValidationDocumentLink string        `json:"validationDocumentLink"`
+	// State of the account: valid, pendingValidation or
+	// blockedForIncidents.
+	State              string `json:"state"`
+	DefaultPaymentMean bool   `json:"defaultPaymentMean"`
+}
+
+// PayPal is a PayPal account registered on the account.
+type PayPal struct {
+	ID                 int64         `json:"id"`
+	Description        string        `json:"description"`
+	Email              string        `json:"email"`
+	AgreementID        string        `json:"agreementId"`
+	CreationDate       govh.DateTime `json:"creationDate"`
+	State              string        `json:"state"`
+	DefaultPaymentMean bool          `json:"defaultPaymentMean"`
+}
+
+// PaymentMeans returns the identifiers of the payment means of the given type.
+func (client *Client) PaymentMeans(ctx context.Context, paymentMeanType PaymentMeanType) ([]int64, error) {
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, "/me/paymentMean/"+string(paymentMeanType), &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// CreditCard returns the details of a credit card.
+func (client *Client) CreditCard(ctx context.Context, id int64) (*CreditCard, error) {
+	card := &CreditCard{}
+	if err := client.api.GetWithContext(ctx, paymentMeanPath(PaymentMeanCreditCard, id), card); err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+// BankAccount returns the details of a bank account.
+func (client *Client) BankAccount(ctx context.Context, id int64) (*BankAccount, error) {
+	account := &BankAccount{}
+	if err := client.api.GetWithContext(ctx, paymentMeanPath(PaymentMeanBankAccount, id), account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// PayPal returns the details of a PayPal account.
+func (client *Client) PayPal(ctx context.Context, id int64) (*PayPal, error) {
+	paypal := &PayPal{}
+	if err := client.api.GetWithContext(ctx, paymentMeanPath(PaymentMeanPayPal, id), paypal); err != nil {
+		return nil, err
+	}
+	return paypal, nil
+}
+
+// ChooseDefaultPaymentMean makes a payment mean the default one of the
+// account.
+func (client *Client) ChooseDefaultPaymentMean(ctx context.Context, paymentMeanType PaymentMeanType, id int64) error {
+	return client.api.PostWithContext(ctx, paymentMeanPath(paymentMeanType, id)+"/chooseAsDefaultPaymentMean", nil, nil)
+}
+
+// ChallengePaymentMean answers the challenge validating a payment mean, such
+// as the amount of the test transaction made with a credit card.
+func (client *Client) ChallengePaymentMean(ctx context.Context, paymentMeanType PaymentMeanType, id int64, challenge string) error {
+	body := map[string]interface{}{"challenge": challenge}
+	return client.api.PostWithContext(ctx, paymentMeanPath(paymentMeanType, id)+"/challenge", body, nil)
+}
+
+// DeletePaymentMean removes a payment mean. The default payment mean can't
+// be removed: another one must be chosen first.
+func (client *Client) DeletePaymentMean(ctx context.Context, paymentMeanType PaymentMeanType, id int64) error {
+	return client.api.DeleteWithContext(ctx, paymentMeanPath(paymentMeanType, id), nil)
+}
+
+func paymentMeanPath(paymentMeanType PaymentMeanType, id int64) string {
+	return fmt.Sprintf("/me/paymentMean/%s/%d", paymentMeanType, id)
+}
@@ -0,0 +1,66 @@
+package me
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestPaymentMeans(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /me/paymentMean/creditCard", 200, []int64{7, 8})
+	server.Handle("GET /me/paymentMean/creditCard/8", 200, map[string]interface{}{
+		"id":                 8,
+		"number":             "XXXX XXXX XXXX 1234",
+		"type":               "VISA",
+		"expirationDate":     "2027-12-31T00:00:00+01:00",
+		"state":              "valid",
+		"defaultPaymentMean": false,
+	})
+	server.Handle("GET /me/paymentMean/bankAccount/3", 200, map[string]interface{}{"id": 3, "iban": "FR7630001007941234567890185", "state": "valid"})
+	server.Handle("POST /me/paymentMean/creditCard/8/chooseAsDefaultPaymentMean", 200, nil)
+	server.Handle("POST /me/paymentMean/creditCard/8/challenge", 200, nil)
+	server.Handle("DELETE /me/paymentMean/creditCard/7", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	ids, err := client.PaymentMeans(ctx, PaymentMeanCreditCard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("unexpected credit cards %v", ids)
+	}
+
+	card, err := client.CreditCard(ctx, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if card.Type != "VISA" || card.ExpirationDate.Year() != 2027 || card.DefaultPaymentMean {
+		t.Fatalf("unexpected credit card %+v", card)
+	}
+
+	account, err := client.BankAccount(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.IBAN != "FR7630001007941234567890185" {
+		t.Fatalf("unexpected bank account %+v", account)
+	}
+
+	if err := client.ChallengePaymentMean(ctx, PaymentMeanCreditCard, 8, "1.23"); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"challenge":"1.23"}` {
+		t.Fatalf("unexpected challenge %s", body)
+	}
+	if err := client.ChooseDefaultPaymentMean(ctx, PaymentMeanCreditCard, 8); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.DeletePaymentMean(ctx, PaymentMeanCreditCard, 7); err != nil {
+		t.Fatal(err)
+	}
+}
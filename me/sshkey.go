@@ -0,0 +1,87 @@
+package me
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// SSHKey is a public SSH key of the account, which can be installed on
+// dedicated servers.
+type SSHKey struct {
+	KeyName string `json:"keyName"`
+	Key     string `json:"key"`
+	// Whether the key is installed by default, e.g. in rescue mode.
+	Default bool `json:"default"`
+}
+
+// SSHKeys returns the names of the SSH keys of the account.
+func (client *Client) SSHKeys(ctx context.Context) ([]string, error) {
+	var names []string
+	if err := client.api.GetWithContext(ctx, "/me/sshKey", &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// SSHKey returns an SSH key.
+func (client *Client) SSHKey(ctx context.Context, keyName string) (*SSHKey, error) {
+	key := &SSHKey{}
+	if err := client.api.GetWithContext(ctx, sshKeyPath(keyName), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// CreateSSHKey adds a public SSH key to the account.
+func (client *Client) CreateSSHKey(ctx context.Context, keyName, key string) error {
+	body := map[string]interface{}{"keyName": keyName, "key": key}
+	return client.api.PostWithContext(ctx, "/me/sshKey", body, nil)
+}
+
+// SetDefaultSSHKey makes an SSH key the default one of the account.
+func (client *Client) SetDefaultSSHKey(ctx context.Context, keyName string, isDefault bool) error {
+	body := map[string]interface{}{"default": isDefault}
+	return client.api.PutWithContext(ctx, sshKeyPath(keyName), body, nil)
+}
+
+// DeleteSSHKey removes an SSH key from the account.
+func (client *Client) DeleteSSHKey(ctx context.Context, keyName string) error {
+	return client.api.DeleteWithContext(ctx, sshKeyPath(keyName), nil)
+}
+
+// EnsureSSHKey adds a public SSH key to the account, unless it already has
+// it, and tells whether it was added. An error is returned if another key
+// has the same name.
+func (client *Client) EnsureSSHKey(ctx context.Context, keyName, key string) (bool, error) {
+	existing, err := client.SSHKey(ctx, keyName)
+	if govh.IsNotFound(err) {
+		err := client.CreateSSHKey(ctx, keyName, key)
+		return err == nil, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if sshKeyFields(existing.Key) != sshKeyFields(key) {
+		return false, fmt.Errorf("SSH key %s already exists with another key", keyName)
+	}
+	return false, nil
+}
+
+// sshKeyFields returns the type and data of an authorized_keys line, without
+// its comment.
+func sshKeyFields(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) > 2 {
+		fields = fields[:2]
+	}
+	return strings.Join(fields, " ")
+}
+
+func sshKeyPath(keyName string) string {
+	return "/me/sshKey/" + url.PathEscape(keyName)
+}
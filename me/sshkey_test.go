@@ -0,0 +1,56 @@
+package me
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestSSHKeys(t *testing.T) {
+	const key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIExampleKey deploy@ci"
+
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /me/sshKey", 200, []string{"deploy"})
+	server.Handle("GET /me/sshKey/deploy", 200, map[string]interface{}{"keyName": "deploy", "key": key, "default": false})
+	server.HandleError("GET /me/sshKey/backup", 404, "Client::NotFound", "The requested object (keyName = backup) does not exist")
+	server.Handle("POST /me/sshKey", 200, nil)
+	server.Handle("PUT /me/sshKey/deploy", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	names, err := client.SSHKeys(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 || names[0] != "deploy" {
+		t.Fatalf("unexpected keys %v", names)
+	}
+
+	created, err := client.EnsureSSHKey(ctx, "deploy", "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIExampleKey")
+	if err != nil || created {
+		t.Fatalf("expected the existing key to be kept, got %v, %v", created, err)
+	}
+	if _, err := client.EnsureSSHKey(ctx, "deploy", "ssh-ed25519 AAAAOtherKey"); err == nil {
+		t.Fatal("expected an error for a conflicting key")
+	}
+
+	created, err = client.EnsureSSHKey(ctx, "backup", "ssh-ed25519 AAAABackupKey")
+	if err != nil || !created {
+		t.Fatalf("expected the key to be created, got %v, %v", created, err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"key":"ssh-ed25519 AAAABackupKey","keyName":"backup"}` {
+		t.Fatalf("unexpected creation %s", body)
+	}
+
+	if err := client.SetDefaultSSHKey(ctx, "deploy", true); err != nil {
+		t.Fatal(err)
+	}
+	server.HandleError("POST /me/sshKey", 400, "Client::BadRequest", "Invalid key")
+	created, err = client.EnsureSSHKey(ctx, "backup", "ssh-ed25519 AAAABackupKey")
+	if err == nil || created {
+		t.Fatalf("expected a failed creation, got %v, %v", created, err)
+	}
+}
@@ -0,0 +1,80 @@
+package govh
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// MetricsCollector receives metrics about the calls made by a Caller.
+// It must be safe for concurrent use.
+type MetricsCollector interface {
+	ObserveCall(metrics CallMetrics)
+}
+
+// CallMetrics describes a call made by a Caller, including its retries.
+type CallMetrics struct {
+	// HTTP method of the call.
+	Method string
+	// Route of the call, with identifiers replaced by placeholders, such as
+	// "/domain/zone/{id}/record". See WithRoute.
+	Route string
+	// HTTP status code of the response, zero if there is none.
+	StatusCode int
+	// Error of the call, if it failed before getting a response.
+	Err error
+	// Duration of the call.
+	Duration time.Duration
+}
+
+// WithRoute sets the route reported to metrics collectors and tracers for the
+// call, such as "/domain/zone/{zoneName}/record". By default, path segments
+// which look like identifiers are replaced by "{id}".
+func WithRoute(route string) CallOption {
+	return func(options *callOptions) {
+		options.route = route
+	}
+}
+
+// observeCall reports a call to the metrics collector, if any.
+func (caller *Caller) observeCall(options *callOptions, method, path string, result *http.Response, err error, duration time.Duration) {
+	if caller.Metrics == nil {
+		return
+	}
+
+	metrics := CallMetrics{
+		Method:   method,
+		Route:    options.routeFor(path),
+		Err:      err,
+		Duration: duration,
+	}
+	if result != nil {
+		metrics.StatusCode = result.StatusCode
+	}
+	caller.Metrics.ObserveCall(metrics)
+}
+
+// routeFor returns the route of a call to path.
+func (options *callOptions) routeFor(path string) string {
+	if options.route != "" {
+		return options.route
+	}
+	return routeTemplate(path)
+}
+
+// routeTemplate replaces the segments of path looking like identifiers, i.e.
+// containing a digit, a dot, a colon or an at sign, with "{id}", so that
+// routes have a bounded cardinality. The query string is removed.
+func routeTemplate(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.ContainsAny(segment, "0123456789.:@%") {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,73 @@
+package govh
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRouteTemplate(t *testing.T) {
+	for path, expected := range map[string]string{
+		"/me":                                     "/me",
+		"/me/bill/FR12345":                        "/me/bill/{id}",
+		"/domain/zone/example.com/record/123":     "/domain/zone/{id}/record/{id}",
+		"/dedicated/server?datacenter=gra1":       "/dedicated/server",
+		"/me/contact/xx1234-ovh@example.com/info": "/me/contact/{id}/info",
+	} {
+		if route := routeTemplate(path); route != expected {
+			t.Errorf("%s: expected %s, got %s", path, expected, route)
+		}
+	}
+}
+
+func TestPrometheusMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/domain/zone/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	metrics := NewPrometheusMetrics("test", 0.5, 0.1)
+	c := &Caller{URL: server.URL, Metrics: metrics}
+	c.Get("/domain/zone/example.com", nil)
+	c.Get("/domain/zone/missing.com", nil)
+	c.Get("/domain/zone/missing.com", nil)
+	c.CallAPIWithContext(context.Background(), "/domain/zone/example.com/refresh", "POST", nil, nil, WithRoute("/domain/zone/{zoneName}/refresh"))
+
+	metrics.ObserveCall(CallMetrics{Method: "GET", Route: "/me", Duration: time.Second})
+
+	var out bytes.Buffer
+	if _, err := metrics.WriteTo(&out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"# TYPE test_ovh_requests_total counter\n",
+		`test_ovh_requests_total{method="GET",route="/domain/zone/{id}",status="200"} 1` + "\n",
+		`test_ovh_requests_total{method="GET",route="/domain/zone/{id}",status="404"} 2` + "\n",
+		`test_ovh_requests_total{method="POST",route="/domain/zone/{zoneName}/refresh",status="200"} 1` + "\n",
+		`test_ovh_requests_total{method="GET",route="/me",status="error"} 1` + "\n",
+		"# TYPE test_ovh_request_duration_seconds histogram\n",
+		`test_ovh_request_duration_seconds_bucket{method="GET",route="/domain/zone/{id}",le="+Inf"} 3` + "\n",
+		`test_ovh_request_duration_seconds_count{method="GET",route="/domain/zone/{id}"} 3` + "\n",
+		`test_ovh_request_duration_seconds_bucket{method="GET",route="/me",le="0.5"} 0` + "\n",
+		`test_ovh_request_duration_seconds_sum{method="GET",route="/me"} 1` + "\n",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("expected %q in:\n%s", expected, out.String())
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	metrics.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	if recorder.Body.String() != out.String() {
+		t.Fatal("unexpected served metrics")
+	}
+}
@@ -0,0 +1,28 @@
+package govh
+
+import "net/http"
+
+// RoundTripFunc performs a single HTTP request and returns its response.
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc, to observe or alter requests and responses.
+// Requests are already signed when they reach a middleware, and responses are
+// seen before their body is read and unmarshalled.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use appends middlewares to the caller's chain.
+// The first registered middleware is the outermost one.
+// Like other settings, middlewares must be added before the first call.
+func (caller *Caller) Use(middlewares ...Middleware) {
+	caller.Middlewares = append(caller.Middlewares, middlewares...)
+}
+
+// roundTrip returns the function performing requests through the middleware
+// chain.
+func (caller *Caller) roundTrip() RoundTripFunc {
+	rt := RoundTripFunc(caller.httpClient().Do)
+	for i := len(caller.Middlewares) - 1; i >= 0; i-- {
+		rt = caller.Middlewares[i](rt)
+	}
+	return rt
+}
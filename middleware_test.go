@@ -0,0 +1,42 @@
+package govh
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestMiddlewares(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var trace []string
+	record := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				if req.Header.Get("X-Ovh-Signature") == "" {
+					t.Errorf("%s: request is not signed", name)
+				}
+				trace = append(trace, name+" before")
+				resp, err := next(req)
+				trace = append(trace, name+" after")
+				return resp, err
+			}
+		}
+	}
+
+	c := &Caller{URL: server.URL}
+	c.Use(record("outer"), record("inner"))
+
+	if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"outer before", "inner before", "inner after", "outer after"}
+	if !reflect.DeepEqual(trace, expected) {
+		t.Fatalf("unexpected middleware order: %v", trace)
+	}
+}
@@ -0,0 +1,102 @@
+// Package newaccount wraps the /newAccount routes of the OVH API, which
+// create OVH accounts. They are public: no credentials are needed.
+//
+// The fields required to create an account depend on its country and legal
+// form. They are given by Rules, which can check an account before creating
+// it:
+//
+//	rules, err := client.Rules(ctx, &newaccount.RulesParams{
+//		Action:        newaccount.ActionCreate,
+//		Country:       "FR",
+//		LegalForm:     "individual",
+//		OvhCompany:    "ovh",
+//		OvhSubsidiary: "FR",
+//	})
+//	if err := rules.Validate(account); err != nil {
+//		// Report the field at fault.
+//	}
+package newaccount
+
+import (
+	"context"
+	"net/url"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Client calls the /newAccount routes.
+type Client struct {
+	api govh.Client
+}
+
+// New returns a Client calling the API with client, usually a *govh.Caller.
+func New(client govh.Client) *Client {
+	return &Client{api: client}
+}
+
+// AccountCreation describes an account to create.
+type AccountCreation struct {
+	Email         string `json:"email"`
+	Country       string `json:"country"`
+	LegalForm     string `json:"legalform"`
+	OvhCompany    string `json:"ovhCompany"`
+	OvhSubsidiary string `json:"ovhSubsidiary"`
+	Language      string `json:"language,omitempty"`
+	FirstName     string `json:"firstname,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Organisation  string `json:"organisation,omitempty"`
+	Sex           string `json:"sex,omitempty"`
+	BirthDay      string `json:"birthDay,omitempty"`
+	BirthCity     string `json:"birthCity,omitempty"`
+	Address       string `json:"address,omitempty"`
+	Zip           string `json:"zip,omitempty"`
+	City          string `json:"city,omitempty"`
+	Area          string `json:"area,omitempty"`
+	Phone         string `json:"phone,omitempty"`
+	PhoneCountry  string `json:"phoneCountry,omitempty"`
+	Fax           string `json:"fax,omitempty"`
+	SpareEmail    string `json:"spareEmail,omitempty"`
+	VAT           string `json:"vat,omitempty"`
+	// Identification numbers required in some countries.
+	CorporationType                     string `json:"corporationType,omitempty"`
+	CompanyNationalIdentificationNumber string `json:"companyNationalIdentificationNumber,omitempty"`
+	NationalIdentificationNumber        string `json:"nationalIdentificationNumber,omitempty"`
+}
+
+// CreatedAccount is an account created by Create.
+type CreatedAccount struct {
+	// Nichandle of the account, such as xx1234-ovh.
+	Nichandle string `json:"ovhIdentifier"`
+	// Consumer key of the account, giving access to all its routes.
+	ConsumerKey string `json:"consumerKey"`
+}
+
+// Countries returns the countries in which a subsidiary creates accounts.
+func (client *Client) Countries(ctx context.Context, ovhCompany, ovhSubsidiary string) ([]string, error) {
+	var countries []string
+	query := url.Values{"ovhCompany": {ovhCompany}, "ovhSubsidiary": {ovhSubsidiary}}
+	if err := client.api.GetWithContext(ctx, "/newAccount/countries", &countries, govh.WithQuery(query), govh.Unauthenticated()); err != nil {
+		return nil, err
+	}
+	return countries, nil
+}
+
+// LegalForms returns the legal forms of the accounts of a country.
+func (client *Client) LegalForms(ctx context.Context, country string) ([]string, error) {
+	var legalForms []string
+	query := url.Values{"country": {country}}
+	if err := client.api.GetWithContext(ctx, "/newAccount/legalform", &legalForms, govh.WithQuery(query), govh.Unauthenticated()); err != nil {
+		return nil, err
+	}
+	return legalForms, nil
+}
+
+// Create creates an account. It should be checked by the Validate method of
+// its Rules first, as the errors of the API are terse.
+func (client *Client) Create(ctx context.Context, account *AccountCreation) (*CreatedAccount, error) {
+	created := &CreatedAccount{}
+	if err := client.api.PostWithContext(ctx, "/newAccount", account, created, govh.Unauthenticated()); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
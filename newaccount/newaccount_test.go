@@ -0,0 +1,45 @@
+package newaccount
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestCreate(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /newAccount/countries", 200, []string{"FR", "BE"})
+	server.Handle("GET /newAccount/legalform", 200, []string{"association", "corporation", "individual"})
+	server.Handle("POST /newAccount", 200, map[string]interface{}{"ovhIdentifier": "zz9999-ovh", "consumerKey": "ck"})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	countries, err := client.Countries(ctx, "ovh", "FR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := server.LastRequest()
+	if len(countries) != 2 || request.Signed || request.Query.Get("ovhSubsidiary") != "FR" {
+		t.Fatalf("unexpected countries %v from %+v", countries, request)
+	}
+
+	legalForms, err := client.LegalForms(ctx, "FR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(legalForms) != 3 {
+		t.Fatalf("unexpected legal forms %v", legalForms)
+	}
+
+	created, err := client.Create(ctx, &AccountCreation{Email: "jane@example.com", Country: "FR", LegalForm: "individual", OvhCompany: "ovh", OvhSubsidiary: "FR"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"email":"jane@example.com","country":"FR","legalform":"individual","ovhCompany":"ovh","ovhSubsidiary":"FR"}`
+	if body := string(server.LastRequest().Body); created.Nichandle != "zz9999-ovh" || body != expected {
+		t.Fatalf("unexpected account %+v created with %s", created, body)
+	}
+}
@@ -0,0 +1,171 @@
+package newaccount
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Rule actions.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+)
+
+// Rule constrains a field of the accounts.
+type Rule struct {
+	FieldName string `json:"fieldName"`
+	Mandatory bool   `json:"mandatory"`
+	// Regular expression the value must match, if any.
+	RegularExpression string `json:"regularExpression"`
+	MinLength         int    `json:"minLength"`
+	MaxLength         int    `json:"maxLength"`
+	// Allowed values, if limited.
+	In           []string `json:"in"`
+	DefaultValue string   `json:"defaultValue"`
+	Examples     []string `json:"examples"`
+	Prefix       string   `json:"prefix"`
+}
+
+// Rules are the constraints on the fields of an account.
+type Rules []*Rule
+
+// RulesParams select the rules to return.
+type RulesParams struct {
+	// Action is ActionCreate or ActionUpdate.
+	Action        string `json:"action,omitempty"`
+	Country       string `json:"country"`
+	LegalForm     string `json:"legalform"`
+	OvhCompany    string `json:"ovhCompany"`
+	OvhSubsidiary string `json:"ovhSubsidiary"`
+}
+
+// FieldError is returned by Validate for an invalid field.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (err *FieldError) Error() string {
+	return fmt.Sprintf("Invalid field '%s': %s", err.Field, err.Message)
+}
+
+// Rules returns the constraints on the fields of the accounts selected by
+// params.
+func (client *Client) Rules(ctx context.Context, params *RulesParams) (Rules, error) {
+	var rules Rules
+	if err := client.api.PostWithContext(ctx, "/newAccount/rules", params, &rules, govh.Unauthenticated()); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// CreationRules returns the constraints on the fields of new accounts, from
+// the older /newAccount/creationRules route.
+func (client *Client) CreationRules(ctx context.Context, params *RulesParams) (Rules, error) {
+	query := url.Values{
+		"country":       {params.Country},
+		"legalform":     {params.LegalForm},
+		"ovhCompany":    {params.OvhCompany},
+		"ovhSubsidiary": {params.OvhSubsidiary},
+	}
+
+	var fields map[string]*Rule
+	if err := client.api.GetWithContext(ctx, "/newAccount/creationRules", &fields, govh.WithQuery(query), govh.Unauthenticated()); err != nil {
+		return nil, err
+	}
+
+	rules := make(Rules, 0, len(fields))
+	for name, rule := range fields {
+		if rule == nil {
+			continue
+		}
+		if rule.FieldName == "" {
+			rule.FieldName = name
+		}
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].FieldName < rules[j].FieldName })
+	return rules, nil
+}
+
+// Rule returns the rule of a field, or nil.
+func (rules Rules) Rule(fieldName string) *Rule {
+	for _, rule := range rules {
+		if rule.FieldName == fieldName {
+			return rule
+		}
+	}
+	return nil
+}
+
+// Validate checks an account against the rules, and returns a *FieldError
+// for the first invalid field.
+func (rules Rules) Validate(account *AccountCreation) error {
+	data, err := json.Marshal(account)
+	if err != nil {
+		return err
+	}
+	var fields map[string]string
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		if err := rule.Check(fields[rule.FieldName]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check checks the value of the field of the rule, empty if not set.
+func (rule *Rule) Check(value string) error {
+	fail := func(format string, args ...interface{}) error {
+		return &FieldError{Field: rule.FieldName, Message: fmt.Sprintf(format, args...)}
+	}
+
+	if value == "" {
+		if rule.Mandatory {
+			return fail("is mandatory")
+		}
+		return nil
+	}
+
+	if rule.MinLength > 0 && len([]rune(value)) < rule.MinLength {
+		return fail("must be at least %d characters long", rule.MinLength)
+	}
+	if rule.MaxLength > 0 && len([]rune(value)) > rule.MaxLength {
+		return fail("must be at most %d characters long", rule.MaxLength)
+	}
+	if len(rule.In) > 0 {
+		allowed := false
+		for _, in := range rule.In {
+			allowed = allowed || in == value
+		}
+		if !allowed {
+			return fail("must be one of %s", strings.Join(rule.In, ", "))
+		}
+	}
+	if rule.RegularExpression != "" {
+		re, err := regexp.Compile(rule.RegularExpression)
+		if err != nil {
+			// Expressions are written for the API, and may use a syntax Go
+			// doesn't support: leave them to the API.
+			return nil
+		}
+		if !re.MatchString(value) {
+			if len(rule.Examples) > 0 {
+				return fail("has an invalid format, e.g. %s", rule.Examples[0])
+			}
+			return fail("has an invalid format")
+		}
+	}
+	return nil
+}
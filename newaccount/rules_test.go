@@ -0,0 +1,69 @@
+package newaccount
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestRules(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("POST /newAccount/rules", 200, []map[string]interface{}{
+		{"fieldName": "email", "mandatory": true, "regularExpression": `^[^@\s]+@[^@\s]+$`, "examples": []string{"jane@example.com"}},
+		{"fieldName": "zip", "mandatory": true, "regularExpression": `^\d{5}$`},
+		{"fieldName": "language", "mandatory": false, "in": []string{"fr_FR", "en_GB"}},
+		{"fieldName": "firstname", "mandatory": true, "maxLength": 10},
+	})
+	server.Handle("GET /newAccount/creationRules", 200, map[string]interface{}{
+		"zip":   map[string]interface{}{"mandatory": true, "regularExpression": `^\d{5}$`},
+		"email": map[string]interface{}{"mandatory": true},
+		"fax":   nil,
+	})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+	params := &RulesParams{Action: ActionCreate, Country: "FR", LegalForm: "individual", OvhCompany: "ovh", OvhSubsidiary: "FR"}
+
+	rules, err := client.Rules(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 4 || rules.Rule("zip") == nil || server.LastRequest().Signed {
+		t.Fatalf("unexpected rules %+v", rules)
+	}
+
+	account := &AccountCreation{Email: "jane@example.com", Zip: "59100", Language: "fr_FR", FirstName: "Jane"}
+	if err := rules.Validate(account); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		update   func(*AccountCreation)
+		expected string
+	}{
+		{func(a *AccountCreation) { a.Email = "jane" }, "Invalid field 'email': has an invalid format, e.g. jane@example.com"},
+		{func(a *AccountCreation) { a.Zip = "" }, "Invalid field 'zip': is mandatory"},
+		{func(a *AccountCreation) { a.Language = "de_DE" }, "Invalid field 'language': must be one of fr_FR, en_GB"},
+		{func(a *AccountCreation) { a.FirstName = "Janet-Marie-Louise" }, "Invalid field 'firstname': must be at most 10 characters long"},
+	}
+	for _, test := range tests {
+		invalid := *account
+		test.update(&invalid)
+		err := rules.Validate(&invalid)
+		var fieldErr *FieldError
+		if !errors.As(err, &fieldErr) || err.Error() != test.expected {
+			t.Fatalf("expected %q, got %v", test.expected, err)
+		}
+	}
+
+	rules, err = client.CreationRules(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 || rules[0].FieldName != "email" || !rules[1].Mandatory || server.LastRequest().Query.Get("legalform") != "individual" {
+		t.Fatalf("unexpected creation rules %+v", rules)
+	}
+}
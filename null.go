@@ -0,0 +1,71 @@
+package govh
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Null is a value the API may set to null, such as an optional field of a
+// resource. Unlike a pointer, it can be read without nil checks:
+//
+//	var server struct {
+//		RootDevice govh.Null[string] `json:"rootDevice"`
+//	}
+//	if server.RootDevice.Valid { ... }
+//
+// Encoding a Null which is not Valid gives null. It implements IsZero, for
+// fields tagged omitzero to be omitted instead.
+type Null[T any] struct {
+	Value T
+	// Whether Value is set, false for null.
+	Valid bool
+}
+
+// NewNull returns a Null set to value.
+func NewNull[T any](value T) Null[T] {
+	return Null[T]{Value: value, Valid: true}
+}
+
+// Ptr returns a pointer to the value, or nil if it is not set.
+func (null Null[T]) Ptr() *T {
+	if !null.Valid {
+		return nil
+	}
+	return &null.Value
+}
+
+// Or returns the value, or fallback if it is not set.
+func (null Null[T]) Or(fallback T) T {
+	if !null.Valid {
+		return fallback
+	}
+	return null.Value
+}
+
+// IsZero reports whether the value is not set.
+func (null Null[T]) IsZero() bool {
+	return !null.Valid
+}
+
+// MarshalJSON implements json.Marshaler.
+func (null Null[T]) MarshalJSON() ([]byte, error) {
+	if !null.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(null.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (null *Null[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*null = Null[T]{}
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*null = Null[T]{Value: value, Valid: true}
+	return nil
+}
@@ -0,0 +1,39 @@
+package govh
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNull(t *testing.T) {
+	var server struct {
+		RootDevice Null[string] `json:"rootDevice"`
+		LinkSpeed  Null[int]    `json:"linkSpeed"`
+		Missing    Null[bool]   `json:"missing"`
+	}
+	if err := json.Unmarshal([]byte(`{"rootDevice":null,"linkSpeed":1000}`), &server); err != nil {
+		t.Fatal(err)
+	}
+	if server.RootDevice.Valid || server.RootDevice.Ptr() != nil || server.RootDevice.Or("sda") != "sda" {
+		t.Fatalf("unexpected root device %+v", server.RootDevice)
+	}
+	if !server.LinkSpeed.Valid || *server.LinkSpeed.Ptr() != 1000 || server.LinkSpeed.Or(0) != 1000 {
+		t.Fatalf("unexpected link speed %+v", server.LinkSpeed)
+	}
+	if server.Missing.Valid {
+		t.Fatalf("unexpected missing field %+v", server.Missing)
+	}
+
+	if err := json.Unmarshal([]byte(`{"linkSpeed":"fast"}`), &server); err == nil {
+		t.Fatal("expected an error decoding a string into a Null[int]")
+	}
+
+	data, err := json.Marshal(struct {
+		Set     Null[string] `json:"set"`
+		Unset   Null[string] `json:"unset"`
+		Omitted Null[string] `json:"omitted,omitzero"`
+	}{Set: NewNull("")})
+	if err != nil || string(data) != `{"set":"","unset":null}` {
+		t.Fatalf("unexpected encoding %s (%v)", data, err)
+	}
+}
@@ -0,0 +1,174 @@
+package govh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OAuth2 token URLs, by API host.
+var OAuth2TokenURL = map[string]string{
+	"api.ovh.com":         "https://www.ovh.com/auth/oauth2/token",
+	"eu.api.ovh.com":      "https://www.ovh.com/auth/oauth2/token",
+	"ca.api.ovh.com":      "https://ca.ovh.com/auth/oauth2/token",
+	"api.us.ovhcloud.com": "https://us.ovhcloud.com/auth/oauth2/token",
+}
+
+// oauth2TokenExpiryDelta is how long before their expiration tokens are
+// refreshed.
+const oauth2TokenExpiryDelta = time.Minute
+
+// OAuth2Config configures OAuth2 client credentials authentication.
+type OAuth2Config struct {
+	// Identifier and secret of the service account.
+	ClientID     string
+	ClientSecret string
+	// URL of the token endpoint. It is guessed from the API URL when empty.
+	TokenURL string
+	// Requested scope. Defaults to "all".
+	Scope string
+}
+
+// OAuth2Auth authenticates requests with OAuth2 access tokens, obtained with
+// the client credentials of a service account. Tokens are refreshed before
+// they expire.
+type OAuth2Auth struct {
+	config OAuth2Config
+	client func() *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// oauth2TokenResponse is the response of the token endpoint.
+type oauth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// NewOAuth2Auth creates an OAuth2 provider, fetching tokens with client, or
+// http.DefaultClient if nil. Config.TokenURL is required.
+func NewOAuth2Auth(config OAuth2Config, client *http.Client) *OAuth2Auth {
+	return &OAuth2Auth{
+		config: config,
+		client: func() *http.Client {
+			if client == nil {
+				return http.DefaultClient
+			}
+			return client
+		},
+	}
+}
+
+// SignRequest implements AuthProvider.
+func (auth *OAuth2Auth) SignRequest(request *http.Request, body []byte, now time.Time) error {
+	token, err := auth.Token(request.Context())
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Token returns a valid access token, fetching a new one if needed.
+func (auth *OAuth2Auth) Token(ctx context.Context) (string, error) {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+
+	if auth.token != "" && time.Now().Add(oauth2TokenExpiryDelta).Before(auth.expiry) {
+		return auth.token, nil
+	}
+
+	scope := auth.config.Scope
+	if scope == "" {
+		scope = "all"
+	}
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {auth.config.ClientID},
+		"client_secret": {auth.config.ClientSecret},
+		"scope":         {scope},
+	}
+
+	request, err := http.NewRequestWithContext(ctx, "POST", auth.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	result, err := auth.client().Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer result.Body.Close()
+
+	body, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if result.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("OAuth2 token request failed, HTTP response: %d: %s", result.StatusCode, body)
+	}
+
+	token := &oauth2TokenResponse{}
+	if err := json.Unmarshal(body, token); err != nil {
+		return "", err
+	}
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("OAuth2 token response has no access token")
+	}
+
+	auth.token = token.AccessToken
+	auth.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+
+	return auth.token, nil
+}
+
+// Secrets implements SecretHolder.
+func (auth *OAuth2Auth) Secrets() []string {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+
+	return []string{auth.config.ClientSecret, auth.token}
+}
+
+// WithOAuth2 authenticates calls with OAuth2 access tokens obtained with the
+// client credentials of a service account, instead of signing them with an
+// application key and a consumer key.
+func WithOAuth2(clientID, clientSecret string) Option {
+	return WithOAuth2Config(OAuth2Config{ClientID: clientID, ClientSecret: clientSecret})
+}
+
+// WithOAuth2Config is like WithOAuth2, with a complete configuration.
+func WithOAuth2Config(config OAuth2Config) Option {
+	return func(caller *Caller) error {
+		if config.ClientID == "" || config.ClientSecret == "" {
+			return fmt.Errorf("OAuth2 client ID and secret are required")
+		}
+
+		if config.TokenURL == "" {
+			u, err := url.Parse(caller.URL)
+			if err != nil {
+				return err
+			}
+			tokenURL, ok := OAuth2TokenURL[u.Hostname()]
+			if !ok {
+				return fmt.Errorf("Unknown OAuth2 token URL for %s", caller.URL)
+			}
+			config.TokenURL = tokenURL
+		}
+
+		caller.Auth = &OAuth2Auth{config: config, client: caller.httpClient}
+		return nil
+	}
+}
@@ -0,0 +1,56 @@
+package govh
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOAuth2(t *testing.T) {
+	var tokenCalls int
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenCalls++
+		r.ParseForm()
+		if r.Form.Get("grant_type") != "client_credentials" || r.Form.Get("client_id") != "id" || r.Form.Get("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			t.Error("OAuth2 calls should not synchronize time")
+		}
+		if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("X-Ovh-Signature") != "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message":"Invalid token"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithOAuth2Config(OAuth2Config{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		TokenURL:     tokenServer.URL,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if tokenCalls != 1 {
+		t.Fatalf("token should be reused, got %d token requests", tokenCalls)
+	}
+
+	if _, err := NewClient("https://gateway.example.com/1.0", WithOAuth2("id", "secret")); err == nil {
+		t.Fatal("expected an error for an unknown token URL")
+	}
+}
@@ -0,0 +1,304 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Products which can be added to a cart with AddItem, among others listed
+// by GET /order/cart/{cartId}.
+const (
+	ProductCloud           = "cloud"
+	ProductDedicatedServer = "baremetalServers"
+	ProductVPS             = "vps"
+	ProductWebHosting      = "webHosting"
+	ProductEmailDomain     = "emailDomain"
+	ProductIPLoadbalancing = "ipLoadbalancing"
+)
+
+// Cart gathers the items of an order before checkout.
+type Cart struct {
+	CartID      string        `json:"cartId"`
+	Description string        `json:"description"`
+	Expire      govh.DateTime `json:"expire"`
+	Items       []int64       `json:"items"`
+	// Whether the cart was checked out, and can't be modified anymore.
+	ReadOnly bool `json:"readOnly"`
+}
+
+// CartCreation describes a cart to create.
+type CartCreation struct {
+	// Subsidiary selling the items, such as FR or GB.
+	OvhSubsidiary string        `json:"ovhSubsidiary"`
+	Description   string        `json:"description,omitempty"`
+	Expire        govh.DateTime `json:"expire,omitzero"`
+}
+
+// Item is a product in a cart.
+type Item struct {
+	ItemID       int64        `json:"itemId"`
+	CartID       string       `json:"cartId"`
+	ProductID    string       `json:"productId"`
+	Duration     string       `json:"duration"`
+	Settings     ItemSettings `json:"settings"`
+	Prices       []ItemPrice  `json:"prices"`
+	ParentItemID int64        `json:"parentItemId"`
+	// Identifiers of the configurations and options of the item.
+	Configurations []int64 `json:"configurations"`
+	Options        []int64 `json:"options"`
+}
+
+// ItemSettings are the plan, pricing mode and quantity of an item.
+type ItemSettings struct {
+	PlanCode    string `json:"planCode"`
+	PricingMode string `json:"pricingMode"`
+	Quantity    int64  `json:"quantity"`
+}
+
+// ItemPrice is a price of an item, such as its installation or renewal price.
+type ItemPrice struct {
+	Label string     `json:"label"`
+	Price govh.Price `json:"price"`
+}
+
+// ItemCreation describes a product to add to a cart.
+type ItemCreation struct {
+	PlanCode string `json:"planCode"`
+	// Duration of the subscription, in ISO 8601 format, such as P1M.
+	Duration    string `json:"duration"`
+	PricingMode string `json:"pricingMode"`
+	Quantity    int64  `json:"quantity"`
+}
+
+// DomainItemCreation describes a domain to add to a cart.
+type DomainItemCreation struct {
+	Domain string `json:"domain"`
+	// Offer to order, as returned by DomainOffers. It is optional for a
+	// plain registration.
+	OfferID     string `json:"offerId,omitempty"`
+	PlanCode    string `json:"planCode,omitempty"`
+	Duration    string `json:"duration,omitempty"`
+	PricingMode string `json:"pricingMode,omitempty"`
+	Quantity    int64  `json:"quantity,omitempty"`
+}
+
+// ProductOffer is an offer for a product, such as the registration or the
+// transfer of a domain.
+type ProductOffer struct {
+	ProductID   string      `json:"productId"`
+	PlanCode    string      `json:"planCode"`
+	Offer       string      `json:"offer"`
+	OfferID     string      `json:"offerId"`
+	Action      string      `json:"action"`
+	Orderable   bool        `json:"orderable"`
+	Duration    []string    `json:"duration"`
+	PricingMode string      `json:"pricingMode"`
+	Prices      []ItemPrice `json:"prices"`
+}
+
+// RequiredConfiguration is a configuration which must be set on an item
+// before checkout.
+type RequiredConfiguration struct {
+	Label         string   `json:"label"`
+	Type          string   `json:"type"`
+	Required      bool     `json:"required"`
+	AllowedValues []string `json:"allowedValues"`
+}
+
+// Configuration is a configuration set on an item.
+type Configuration struct {
+	ID    int64  `json:"id"`
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// Order is the quote of a cart before checkout, or the order created by
+// checkout.
+type Order struct {
+	// Identifier of the order, set on checkout.
+	OrderID   int64         `json:"orderId"`
+	URL       string        `json:"url"`
+	Prices    OrderPrices   `json:"prices"`
+	Details   []OrderDetail `json:"details"`
+	Contracts []Contract    `json:"contracts"`
+}
+
+// OrderPrices are the total prices of an order.
+type OrderPrices struct {
+	WithTax            govh.Price `json:"withTax"`
+	WithoutTax         govh.Price `json:"withoutTax"`
+	Tax                govh.Price `json:"tax"`
+	OriginalWithoutTax govh.Price `json:"originalWithoutTax"`
+	Reduction          govh.Price `json:"reduction"`
+}
+
+// OrderDetail is a line of an order.
+type OrderDetail struct {
+	CartItemID  int64      `json:"cartItemID"`
+	Description string     `json:"description"`
+	DetailType  string     `json:"detailType"`
+	Domain      string     `json:"domain"`
+	Quantity    int64      `json:"quantity"`
+	UnitPrice   govh.Price `json:"unitPrice"`
+	TotalPrice  govh.Price `json:"totalPrice"`
+}
+
+// Contract is a contract to accept by ordering.
+type Contract struct {
+	Name    string `json:"name"`
+	URL     string `json:"url"`
+	Content string `json:"content"`
+}
+
+// CheckoutParams are the options of a checkout.
+type CheckoutParams struct {
+	// Pay the order with the default payment mean of the account.
+	AutoPayWithPreferredPaymentMethod bool `json:"autoPayWithPreferredPaymentMethod"`
+	// Deliver the order immediately, waiving the right of withdrawal.
+	WaiveRetractationPeriod bool `json:"waiveRetractationPeriod"`
+}
+
+// CreateCart creates a cart. Unless it is created authenticated, the cart
+// must be assigned to the account with AssignCart before checkout.
+func (client *Client) CreateCart(ctx context.Context, creation *CartCreation) (*Cart, error) {
+	cart := &Cart{}
+	if err := client.api.PostWithContext(ctx, "/order/cart", creation, cart); err != nil {
+		return nil, err
+	}
+	return cart, nil
+}
+
+// Cart returns the details of a cart.
+func (client *Client) Cart(ctx context.Context, cartID string) (*Cart, error) {
+	cart := &Cart{}
+	if err := client.api.GetWithContext(ctx, cartPath(cartID), cart); err != nil {
+		return nil, err
+	}
+	return cart, nil
+}
+
+// DeleteCart removes a cart.
+func (client *Client) DeleteCart(ctx context.Context, cartID string) error {
+	return client.api.DeleteWithContext(ctx, cartPath(cartID), nil)
+}
+
+// AssignCart assigns a cart to the account.
+func (client *Client) AssignCart(ctx context.Context, cartID string) error {
+	return client.api.PostWithContext(ctx, cartPath(cartID)+"/assign", nil, nil)
+}
+
+// Offers returns the offers for a product, such as ProductCloud.
+func (client *Client) Offers(ctx context.Context, cartID, product string) ([]*ProductOffer, error) {
+	var offers []*ProductOffer
+	if err := client.api.GetWithContext(ctx, cartPath(cartID)+"/"+product, &offers); err != nil {
+		return nil, err
+	}
+	return offers, nil
+}
+
+// DomainOffers returns the offers for a domain, such as its registration
+// with different durations.
+func (client *Client) DomainOffers(ctx context.Context, cartID, domain string) ([]*ProductOffer, error) {
+	var offers []*ProductOffer
+	query := url.Values{"domain": {domain}}
+	if err := client.api.GetWithContext(ctx, cartPath(cartID)+"/domain", &offers, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return offers, nil
+}
+
+// AddItem adds a product, such as ProductDedicatedServer, to a cart.
+func (client *Client) AddItem(ctx context.Context, cartID, product string, creation *ItemCreation) (*Item, error) {
+	item := &Item{}
+	if err := client.api.PostWithContext(ctx, cartPath(cartID)+"/"+product, creation, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// AddDomain adds a domain to a cart.
+func (client *Client) AddDomain(ctx context.Context, cartID string, creation *DomainItemCreation) (*Item, error) {
+	item := &Item{}
+	if err := client.api.PostWithContext(ctx, cartPath(cartID)+"/domain", creation, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// Items returns the identifiers of the items of a cart.
+func (client *Client) Items(ctx context.Context, cartID string) ([]int64, error) {
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, cartPath(cartID)+"/item", &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Item returns the details of an item.
+func (client *Client) Item(ctx context.Context, cartID string, itemID int64) (*Item, error) {
+	item := &Item{}
+	if err := client.api.GetWithContext(ctx, itemPath(cartID, itemID), item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// DeleteItem removes an item from a cart.
+func (client *Client) DeleteItem(ctx context.Context, cartID string, itemID int64) error {
+	return client.api.DeleteWithContext(ctx, itemPath(cartID, itemID), nil)
+}
+
+// RequiredConfigurations returns the configurations to set on an item, such
+// as the datacenter of a dedicated server.
+func (client *Client) RequiredConfigurations(ctx context.Context, cartID string, itemID int64) ([]*RequiredConfiguration, error) {
+	var configurations []*RequiredConfiguration
+	if err := client.api.GetWithContext(ctx, itemPath(cartID, itemID)+"/requiredConfiguration", &configurations); err != nil {
+		return nil, err
+	}
+	return configurations, nil
+}
+
+// Configure sets a configuration on an item.
+func (client *Client) Configure(ctx context.Context, cartID string, itemID int64, label, value string) (*Configuration, error) {
+	configuration := &Configuration{}
+	body := map[string]interface{}{"label": label, "value": value}
+	if err := client.api.PostWithContext(ctx, itemPath(cartID, itemID)+"/configuration", body, configuration); err != nil {
+		return nil, err
+	}
+	return configuration, nil
+}
+
+// Quote returns the order a checkout of the cart would create, with its
+// prices and contracts.
+func (client *Client) Quote(ctx context.Context, cartID string) (*Order, error) {
+	order := &Order{}
+	if err := client.api.GetWithContext(ctx, cartPath(cartID)+"/checkout", order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// Checkout validates a cart, creating an order to pay. A nil params leaves
+// the order unpaid, see me.Client.PayOrder.
+func (client *Client) Checkout(ctx context.Context, cartID string, params *CheckoutParams) (*Order, error) {
+	if params == nil {
+		params = &CheckoutParams{}
+	}
+
+	order := &Order{}
+	if err := client.api.PostWithContext(ctx, cartPath(cartID)+"/checkout", params, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func cartPath(cartID string) string {
+	return "/order/cart/" + url.PathEscape(cartID)
+}
+
+func itemPath(cartID string, itemID int64) string {
+	return fmt.Sprintf("%s/item/%d", cartPath(cartID), itemID)
+}
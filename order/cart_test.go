@@ -0,0 +1,90 @@
+package order
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestCartWorkflow(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("POST /order/cart", 200, map[string]interface{}{"cartId": "c1", "expire": "2024-03-02T10:00:00+01:00", "items": []int64{}})
+	server.Handle("POST /order/cart/c1/assign", 200, nil)
+	server.Handle("GET /order/cart/c1/domain", 200, []map[string]interface{}{
+		{"productId": "domain", "planCode": "com", "offer": "standard", "orderable": true, "action": "create", "duration": []string{"P1Y"},
+			"prices": []map[string]interface{}{{"label": "PRICE", "price": map[string]interface{}{"currencyCode": "EUR", "value": 9.99, "text": "9.99 €"}}}},
+	})
+	server.Handle("POST /order/cart/c1/domain", 200, map[string]interface{}{"itemId": 1, "cartId": "c1", "productId": "domain", "duration": "P1Y"})
+	server.Handle("POST /order/cart/c1/baremetalServers", 200, map[string]interface{}{"itemId": 2, "cartId": "c1", "settings": map[string]interface{}{"planCode": "24rise01", "pricingMode": "default", "quantity": 1}})
+	server.Handle("GET /order/cart/c1/item/2/requiredConfiguration", 200, []map[string]interface{}{{"label": "dedicated_datacenter", "type": "String", "required": true, "allowedValues": []string{"gra", "rbx"}}})
+	server.Handle("POST /order/cart/c1/item/2/configuration", 200, map[string]interface{}{"id": 10, "label": "dedicated_datacenter", "value": "gra"})
+	server.Handle("GET /order/cart/c1/checkout", 200, map[string]interface{}{
+		"prices":    map[string]interface{}{"withTax": map[string]interface{}{"currencyCode": "EUR", "value": 71.99, "text": "71.99 €"}},
+		"contracts": []map[string]interface{}{{"name": "General Terms", "url": "https://example.com/terms.pdf"}},
+	})
+	server.Handle("POST /order/cart/c1/checkout", 200, map[string]interface{}{"orderId": 123, "url": "https://example.com/order/123"})
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	cart, err := client.CreateCart(ctx, &CartCreation{OvhSubsidiary: "FR"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); cart.CartID != "c1" || body != `{"ovhSubsidiary":"FR"}` {
+		t.Fatalf("unexpected cart %+v created with %s", cart, body)
+	}
+	if err := client.AssignCart(ctx, cart.CartID); err != nil {
+		t.Fatal(err)
+	}
+
+	offers, err := client.DomainOffers(ctx, cart.CartID, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.LastRequest().Query.Get("domain") != "example.com" || len(offers) != 1 || offers[0].Prices[0].Price.String() != "9.99 EUR" {
+		t.Fatalf("unexpected offers %+v", offers)
+	}
+	if _, err := client.AddDomain(ctx, cart.CartID, &DomainItemCreation{Domain: "example.com", Duration: "P1Y"}); err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := client.AddItem(ctx, cart.CartID, ProductDedicatedServer, &ItemCreation{PlanCode: "24rise01", Duration: "P1M", PricingMode: "default", Quantity: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.ItemID != 2 || item.Settings.PlanCode != "24rise01" {
+		t.Fatalf("unexpected item %+v", item)
+	}
+
+	required, err := client.RequiredConfigurations(ctx, cart.CartID, item.ItemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, configuration := range required {
+		if _, err := client.Configure(ctx, cart.CartID, item.ItemID, configuration.Label, configuration.AllowedValues[0]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if body := string(server.LastRequest().Body); body != `{"label":"dedicated_datacenter","value":"gra"}` {
+		t.Fatalf("unexpected configuration %s", body)
+	}
+
+	quote, err := client.Quote(ctx, cart.CartID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quote.Prices.WithTax.String() != "71.99 EUR" || len(quote.Contracts) != 1 {
+		t.Fatalf("unexpected quote %+v", quote)
+	}
+
+	order, err := client.Checkout(ctx, cart.CartID, &CheckoutParams{AutoPayWithPreferredPaymentMethod: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); order.OrderID != 123 || body != `{"autoPayWithPreferredPaymentMethod":true,"waiveRetractationPeriod":false}` {
+		t.Fatalf("unexpected order %+v checked out with %s", order, body)
+	}
+}
@@ -0,0 +1,132 @@
+package order
+
+import (
+	"context"
+	"net/url"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Catalog lists the plans of a product sold by a subsidiary, with their
+// prices. It is public: no credentials are needed to fetch it.
+type Catalog struct {
+	CatalogID    int64         `json:"catalogId"`
+	Locale       CatalogLocale `json:"locale"`
+	Plans        []*Plan       `json:"plans"`
+	Addons       []*Plan       `json:"addons"`
+	Products     []*Product    `json:"products"`
+	PlanFamilies []*PlanFamily `json:"planFamilies"`
+}
+
+// CatalogLocale is the subsidiary of a catalog, and the currency of its
+// prices.
+type CatalogLocale struct {
+	Subsidiary   string  `json:"subsidiary"`
+	CurrencyCode string  `json:"currencyCode"`
+	TaxRate      float64 `json:"taxRate"`
+}
+
+// Plan is a commercial offer, or an addon to an offer.
+type Plan struct {
+	PlanCode       string               `json:"planCode"`
+	InvoiceName    string               `json:"invoiceName"`
+	Product        string               `json:"product"`
+	Family         string               `json:"family"`
+	PricingType    string               `json:"pricingType"`
+	Pricings       []*Pricing           `json:"pricings"`
+	AddonFamilies  []*AddonFamily       `json:"addonFamilies"`
+	Configurations []*PlanConfiguration `json:"configurations"`
+}
+
+// Pricing is a price of a plan, for a capacity, such as installation or
+// renew, and a commitment.
+type Pricing struct {
+	Description  string   `json:"description"`
+	Capacities   []string `json:"capacities"`
+	Mode         string   `json:"mode"`
+	Phase        int64    `json:"phase"`
+	Interval     int64    `json:"interval"`
+	IntervalUnit string   `json:"intervalUnit"`
+	// Commitment in months, 0 for none.
+	Commitment int64  `json:"commitment"`
+	Type       string `json:"type"`
+	// Price and tax in micro-cents, in the currency of the catalog.
+	Price           int64 `json:"price"`
+	Tax             int64 `json:"tax"`
+	MustBeCompleted bool  `json:"mustBeCompleted"`
+}
+
+// AddonFamily is a set of addons of a plan, among which some may have to be
+// chosen.
+type AddonFamily struct {
+	Name      string   `json:"name"`
+	Addons    []string `json:"addons"`
+	Default   string   `json:"default"`
+	Mandatory bool     `json:"mandatory"`
+	Exclusive bool     `json:"exclusive"`
+}
+
+// PlanConfiguration is a configuration to set when ordering a plan, such as
+// its datacenter.
+type PlanConfiguration struct {
+	Name        string   `json:"name"`
+	IsCustom    bool     `json:"isCustom"`
+	IsMandatory bool     `json:"isMandatory"`
+	Values      []string `json:"values"`
+}
+
+// Product is a product sold by plans.
+type Product struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// PlanFamily is a family of plans.
+type PlanFamily struct {
+	Name string `json:"name"`
+}
+
+// Catalog returns the public catalog of a product, such as cloud or
+// baremetalServers, for a subsidiary, such as FR.
+func (client *Client) Catalog(ctx context.Context, product, subsidiary string) (*Catalog, error) {
+	catalog := &Catalog{}
+	query := url.Values{"ovhSubsidiary": {subsidiary}}
+	if err := client.api.GetWithContext(ctx, "/order/catalog/public/"+product, catalog, govh.WithQuery(query), govh.Unauthenticated()); err != nil {
+		return nil, err
+	}
+	return catalog, nil
+}
+
+// Plan returns the plan or addon of the catalog with the given code, or nil.
+func (catalog *Catalog) Plan(planCode string) *Plan {
+	for _, plans := range [][]*Plan{catalog.Plans, catalog.Addons} {
+		for _, plan := range plans {
+			if plan.PlanCode == planCode {
+				return plan
+			}
+		}
+	}
+	return nil
+}
+
+// Price returns a pricing of a plan as a price in the currency of the
+// catalog.
+func (catalog *Catalog) Price(pricing *Pricing) govh.Price {
+	return govh.Price{CurrencyCode: catalog.Locale.CurrencyCode, UCents: pricing.Price}
+}
+
+// Pricing returns the pricing of the plan for a capacity, such as renew or
+// installation, and a billing interval, without commitment, or nil.
+func (plan *Plan) Pricing(capacity string, interval int64) *Pricing {
+	for _, pricing := range plan.Pricings {
+		if pricing.Interval != interval || pricing.Commitment != 0 {
+			continue
+		}
+		for _, c := range pricing.Capacities {
+			if c == capacity {
+				return pricing
+			}
+		}
+	}
+	return nil
+}
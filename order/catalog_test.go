@@ -0,0 +1,64 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+const testCatalog = `{
+  "catalogId": 1234,
+  "locale": {"subsidiary": "FR", "currencyCode": "EUR", "taxRate": 20},
+  "plans": [
+    {
+      "planCode": "24rise01",
+      "invoiceName": "RISE-1",
+      "product": "baremetal-rise-1",
+      "pricingType": "rental",
+      "pricings": [
+        {"capacities": ["installation"], "mode": "default", "phase": 1, "interval": 0, "intervalUnit": "none", "commitment": 0, "price": 0, "tax": 0},
+        {"capacities": ["renew"], "mode": "default", "phase": 1, "interval": 1, "intervalUnit": "month", "commitment": 12, "price": 4999000000, "tax": 999800000},
+        {"capacities": ["renew"], "mode": "default", "phase": 1, "interval": 1, "intervalUnit": "month", "commitment": 0, "price": 5999000000, "tax": 1199800000}
+      ],
+      "addonFamilies": [{"name": "memory", "addons": ["ram-32g-rise01"], "default": "ram-32g-rise01", "mandatory": true, "exclusive": true}],
+      "configurations": [{"name": "dedicated_datacenter", "isCustom": false, "isMandatory": true, "values": ["gra", "rbx"]}]
+    }
+  ],
+  "addons": [{"planCode": "ram-32g-rise01", "pricings": []}]
+}`
+
+func TestCatalog(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /order/catalog/public/baremetalServers", 200, json.RawMessage(testCatalog))
+
+	catalog, err := New(server.Caller()).Catalog(context.Background(), "baremetalServers", "FR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := server.LastRequest()
+	if request.Signed || request.Query.Get("ovhSubsidiary") != "FR" {
+		t.Fatalf("unexpected request %+v", request)
+	}
+
+	plan := catalog.Plan("24rise01")
+	if plan == nil || plan.InvoiceName != "RISE-1" || len(plan.Configurations[0].Values) != 2 {
+		t.Fatalf("unexpected plan %+v", plan)
+	}
+	if catalog.Plan("ram-32g-rise01") == nil || catalog.Plan("unknown") != nil {
+		t.Fatal("unexpected addon lookup")
+	}
+
+	pricing := plan.Pricing("renew", 1)
+	if pricing == nil {
+		t.Fatal("no renew pricing")
+	}
+	if price := catalog.Price(pricing); price.String() != "59.99 EUR" {
+		t.Fatalf("unexpected price %s", price)
+	}
+	if plan.Pricing("renew", 12) != nil {
+		t.Fatal("unexpected pricing for a yearly interval")
+	}
+}
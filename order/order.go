@@ -0,0 +1,15 @@
+// Package order wraps the /order routes of the OVH API, which purchase new
+// services through carts.
+package order
+
+import govh "github.com/garbage-collector/ovh-go"
+
+// Client calls the /order routes.
+type Client struct {
+	api govh.Client
+}
+
+// New returns a Client calling the API with client, usually a *govh.Caller.
+func New(client govh.Client) *Client {
+	return &Client{api: client}
+}
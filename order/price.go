@@ -0,0 +1,23 @@
+package order
+
+import (
+	"context"
+	"strings"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Price returns a price of the legacy /price API, which gives the prices of
+// products and options by path, such as
+// "dedicated/server/antiDDoSPro/cloud-25":
+//
+//	price, err := client.Price(ctx, "domain/zone/option/dnsAnycast")
+//
+// It is public: no credentials are needed.
+func (client *Client) Price(ctx context.Context, path string) (*govh.Price, error) {
+	price := &govh.Price{}
+	if err := client.api.GetWithContext(ctx, "/price/"+strings.TrimPrefix(path, "/"), price, govh.Unauthenticated()); err != nil {
+		return nil, err
+	}
+	return price, nil
+}
@@ -0,0 +1,22 @@
+package order
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestPrice(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /price/domain/zone/option/dnsAnycast", 200, map[string]interface{}{"currencyCode": "EUR", "value": 1.2, "text": "1.20 €"})
+
+	price, err := New(server.Caller()).Price(context.Background(), "/domain/zone/option/dnsAnycast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price.String() != "1.20 EUR" || server.LastRequest().Signed {
+		t.Fatalf("unexpected price %s", price)
+	}
+}
@@ -0,0 +1,206 @@
+package govh
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrCurrencyMismatch is returned when adding or comparing prices in
+// different currencies.
+var ErrCurrencyMismatch = errors.New("govh: prices in different currencies")
+
+// ErrPriceOverflow is returned when a price doesn't fit a Price.
+var ErrPriceOverflow = errors.New("govh: price overflow")
+
+// UCentsPerUnit is the number of micro-cents in a currency unit.
+const UCentsPerUnit = 100000000
+
+// Price is an amount of money, as returned by the billing, order and catalog
+// APIs: {"currencyCode": "EUR", "value": 1.99, "text": "1.99 €"}.
+//
+// The amount is held as an integer number of micro-cents, so that sums are
+// exact, unlike the float value of the API.
+type Price struct {
+	// ISO 4217 currency code, such as "EUR", or "points" for loyalty points.
+	CurrencyCode string
+	// Amount in micro-cents, i.e. 1e-8 of the currency unit, as the
+	// priceInUcents field of the API.
+	UCents int64
+	// Amount formatted by the API for display, e.g. "1.99 €". It is empty for
+	// prices computed locally.
+	Text string
+}
+
+// ParsePrice returns the price of a decimal amount, such as "1.99".
+func ParsePrice(currencyCode, amount string) (Price, error) {
+	ucents, err := parseUCents(amount)
+	if err != nil {
+		return Price{}, err
+	}
+	return Price{CurrencyCode: currencyCode, UCents: ucents}, nil
+}
+
+// Add returns the sum of two prices in the same currency. A zero Price may be
+// added to any price.
+func (price Price) Add(other Price) (Price, error) {
+	currency, err := price.currencyWith(other)
+	if err != nil {
+		return Price{}, err
+	}
+	sum := price.UCents + other.UCents
+	if (sum > price.UCents) != (other.UCents > 0) {
+		return Price{}, ErrPriceOverflow
+	}
+	return Price{CurrencyCode: currency, UCents: sum}, nil
+}
+
+// Sub returns the difference of two prices in the same currency.
+func (price Price) Sub(other Price) (Price, error) {
+	if other.UCents == math.MinInt64 {
+		return Price{}, ErrPriceOverflow
+	}
+	other.UCents = -other.UCents
+	return price.Add(other)
+}
+
+// Mul returns the price multiplied by n, e.g. a monthly price by a number of
+// months.
+func (price Price) Mul(n int64) (Price, error) {
+	product := price.UCents * n
+	if n != 0 && (product/n != price.UCents || (n == -1 && price.UCents == math.MinInt64)) {
+		return Price{}, ErrPriceOverflow
+	}
+	return Price{CurrencyCode: price.CurrencyCode, UCents: product}, nil
+}
+
+// Cmp compares two prices in the same currency, returning -1, 0 or +1.
+func (price Price) Cmp(other Price) (int, error) {
+	if _, err := price.currencyWith(other); err != nil {
+		return 0, err
+	}
+	switch {
+	case price.UCents < other.UCents:
+		return -1, nil
+	case price.UCents > other.UCents:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// IsZero reports whether the amount is zero.
+func (price Price) IsZero() bool {
+	return price.UCents == 0
+}
+
+// Float64 returns the amount in currency units, possibly rounded.
+func (price Price) Float64() float64 {
+	return float64(price.UCents) / UCentsPerUnit
+}
+
+// Amount returns the exact decimal amount, with at least two decimals, e.g.
+// "1.99" or "0.0049".
+func (price Price) Amount() string {
+	return formatUCents(price.UCents, 2)
+}
+
+// String returns the amount followed by the currency, e.g. "1.99 EUR".
+func (price Price) String() string {
+	if price.CurrencyCode == "" {
+		return price.Amount()
+	}
+	return price.Amount() + " " + price.CurrencyCode
+}
+
+// currencyWith returns the currency of the result of an operation on two
+// prices. Prices without currency, such as the zero Price, take the currency
+// of the other one.
+func (price Price) currencyWith(other Price) (string, error) {
+	switch {
+	case price.CurrencyCode == other.CurrencyCode || other.CurrencyCode == "":
+		return price.CurrencyCode, nil
+	case price.CurrencyCode == "":
+		return other.CurrencyCode, nil
+	}
+	return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, price.CurrencyCode, other.CurrencyCode)
+}
+
+type jsonPrice struct {
+	CurrencyCode  string      `json:"currencyCode"`
+	Text          string      `json:"text,omitempty"`
+	Value         json.Number `json:"value"`
+	PriceInUcents *int64      `json:"priceInUcents,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (price Price) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonPrice{
+		CurrencyCode: price.CurrencyCode,
+		Text:         price.Text,
+		Value:        json.Number(formatUCents(price.UCents, 0)),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The exact priceInUcents field is
+// used when present, the value otherwise.
+func (price *Price) UnmarshalJSON(data []byte) error {
+	var p jsonPrice
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+
+	*price = Price{CurrencyCode: p.CurrencyCode, Text: p.Text}
+	switch {
+	case p.PriceInUcents != nil:
+		price.UCents = *p.PriceInUcents
+	case p.Value != "":
+		ucents, err := parseUCents(p.Value.String())
+		if err != nil {
+			return err
+		}
+		price.UCents = ucents
+	}
+	return nil
+}
+
+// parseUCents parses a decimal amount of currency units into micro-cents.
+func parseUCents(amount string) (int64, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok {
+		return 0, fmt.Errorf("Invalid price %q", amount)
+	}
+	r.Mul(r, big.NewRat(UCentsPerUnit, 1))
+	if !r.IsInt() {
+		return 0, fmt.Errorf("Invalid price %q: more than 8 decimals", amount)
+	}
+	if !r.Num().IsInt64() {
+		return 0, ErrPriceOverflow
+	}
+	return r.Num().Int64(), nil
+}
+
+// formatUCents formats micro-cents as a decimal amount, with at least
+// minDecimals decimals.
+func formatUCents(ucents int64, minDecimals int) string {
+	sign := ""
+	u := uint64(ucents)
+	if ucents < 0 {
+		sign = "-"
+		u = -u
+	}
+
+	units := strconv.FormatUint(u/UCentsPerUnit, 10)
+	decimals := fmt.Sprintf("%08d", u%UCentsPerUnit)
+	decimals = strings.TrimRight(decimals, "0")
+	for len(decimals) < minDecimals {
+		decimals += "0"
+	}
+	if decimals == "" {
+		return sign + units
+	}
+	return sign + units + "." + decimals
+}
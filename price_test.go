@@ -0,0 +1,96 @@
+package govh
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPriceJSON(t *testing.T) {
+	for input, expected := range map[string]Price{
+		`{"currencyCode":"EUR","text":"1.99 €","value":1.99}`:                            {CurrencyCode: "EUR", UCents: 199000000, Text: "1.99 €"},
+		`{"currencyCode":"EUR","value":0.1}`:                                             {CurrencyCode: "EUR", UCents: 10000000},
+		`{"currencyCode":"USD","value":1e3}`:                                             {CurrencyCode: "USD", UCents: 100000000000},
+		`{"currencyCode":"EUR","text":"0.0049 €","value":0.0049,"priceInUcents":490000}`: {CurrencyCode: "EUR", UCents: 490000, Text: "0.0049 €"},
+		`{"currencyCode":"points","value":-12}`:                                          {CurrencyCode: "points", UCents: -1200000000},
+	} {
+		var price Price
+		if err := json.Unmarshal([]byte(input), &price); err != nil {
+			t.Fatalf("%s: %s", input, err)
+		}
+		if price != expected {
+			t.Fatalf("%s: got %+v, expected %+v", input, price, expected)
+		}
+	}
+
+	for _, input := range []string{
+		`{"currencyCode":"EUR","value":0.000000001}`,
+		`{"currencyCode":"EUR","value":1e30}`,
+		`{"currencyCode":"EUR","value":"abc"}`,
+	} {
+		var price Price
+		if err := json.Unmarshal([]byte(input), &price); err == nil {
+			t.Fatalf("%s: expected an error", input)
+		}
+	}
+
+	data, err := json.Marshal(Price{CurrencyCode: "EUR", UCents: 199000000, Text: "1.99 €"})
+	if err != nil || string(data) != `{"currencyCode":"EUR","text":"1.99 €","value":1.99}` {
+		t.Fatalf("unexpected encoding %s (%v)", data, err)
+	}
+}
+
+func TestPriceArithmetic(t *testing.T) {
+	// 0.1 + 0.2 is exact, unlike with floats
+	a, _ := ParsePrice("EUR", "0.1")
+	b, _ := ParsePrice("EUR", "0.2")
+	sum, err := a.Add(b)
+	if err != nil || sum.String() != "0.30 EUR" {
+		t.Fatalf("unexpected sum %s (%v)", sum, err)
+	}
+
+	var total Price
+	for i := 0; i < 3; i++ {
+		if total, err = total.Add(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if cmp, err := total.Cmp(sum); err != nil || cmp != 0 {
+		t.Fatalf("expected %s to equal %s (%v)", total, sum, err)
+	}
+
+	yearly, err := sum.Mul(12)
+	if err != nil || yearly.Amount() != "3.60" || yearly.Float64() != 3.6 {
+		t.Fatalf("unexpected product %s (%v)", yearly, err)
+	}
+	diff, err := a.Sub(b)
+	if err != nil || diff.String() != "-0.10 EUR" {
+		t.Fatalf("unexpected difference %s (%v)", diff, err)
+	}
+	if small, _ := ParsePrice("EUR", "0.0049"); small.Amount() != "0.0049" {
+		t.Fatalf("unexpected amount %s", small.Amount())
+	}
+
+	usd, _ := ParsePrice("USD", "1")
+	if _, err := a.Add(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expected a currency mismatch, got %v", err)
+	}
+	if _, err := a.Cmp(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expected a currency mismatch, got %v", err)
+	}
+
+	max := Price{CurrencyCode: "EUR", UCents: math.MaxInt64}
+	if _, err := max.Add(a); err != ErrPriceOverflow {
+		t.Fatalf("expected an overflow, got %v", err)
+	}
+	if _, err := max.Mul(2); err != ErrPriceOverflow {
+		t.Fatalf("expected an overflow, got %v", err)
+	}
+	if _, err := (Price{UCents: math.MinInt64}).Mul(-1); err != ErrPriceOverflow {
+		t.Fatalf("expected an overflow, got %v", err)
+	}
+	if _, err := ParsePrice("EUR", "1.5.2"); err == nil {
+		t.Fatal("expected an error for an invalid amount")
+	}
+}
@@ -0,0 +1,189 @@
+package govh
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the latency
+// histogram of PrometheusMetrics.
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// PrometheusMetrics is a MetricsCollector exposing metrics in the Prometheus
+// text format. It serves them over HTTP, so it can be registered on the
+// /metrics route of an exporter:
+//
+//	metrics := govh.NewPrometheusMetrics("myapp")
+//	caller, err := govh.NewClient("ovh-eu", govh.WithMetrics(metrics), ...)
+//	http.Handle("/metrics", metrics)
+//
+// Two metrics are exposed: <namespace>_ovh_requests_total, by method, route
+// and status, and <namespace>_ovh_request_duration_seconds, by method and
+// route. The status is "error" for calls which got no response.
+type PrometheusMetrics struct {
+	namespace string
+	buckets   []float64
+
+	mu         sync.Mutex
+	counts     map[promRequestKey]uint64
+	histograms map[promRouteKey]*promHistogram
+}
+
+type promRouteKey struct {
+	method, route string
+}
+
+type promRequestKey struct {
+	promRouteKey
+	status string
+}
+
+type promHistogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewPrometheusMetrics creates a collector whose metrics are prefixed by
+// namespace, if not empty. The latency histogram uses buckets, or
+// DefaultLatencyBuckets if none is given.
+func NewPrometheusMetrics(namespace string, buckets ...float64) *PrometheusMetrics {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	if namespace != "" {
+		namespace += "_"
+	}
+	return &PrometheusMetrics{
+		namespace:  namespace,
+		buckets:    buckets,
+		counts:     make(map[promRequestKey]uint64),
+		histograms: make(map[promRouteKey]*promHistogram),
+	}
+}
+
+// ObserveCall implements MetricsCollector.
+func (metrics *PrometheusMetrics) ObserveCall(call CallMetrics) {
+	route := promRouteKey{method: call.Method, route: call.Route}
+	status := "error"
+	if call.StatusCode != 0 {
+		status = strconv.Itoa(call.StatusCode)
+	}
+	seconds := call.Duration.Seconds()
+
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+
+	metrics.counts[promRequestKey{promRouteKey: route, status: status}]++
+
+	histogram, ok := metrics.histograms[route]
+	if !ok {
+		histogram = &promHistogram{counts: make([]uint64, len(metrics.buckets))}
+		metrics.histograms[route] = histogram
+	}
+	for i, bound := range metrics.buckets {
+		if seconds <= bound {
+			histogram.counts[i]++
+		}
+	}
+	histogram.count++
+	histogram.sum += seconds
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format.
+func (metrics *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	metrics.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text format.
+func (metrics *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+
+	buffered := bufio.NewWriter(w)
+	out := &countingWriter{w: buffered}
+
+	requests := make([]promRequestKey, 0, len(metrics.counts))
+	for key := range metrics.counts {
+		requests = append(requests, key)
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		if requests[i].promRouteKey != requests[j].promRouteKey {
+			return requests[i].promRouteKey.less(requests[j].promRouteKey)
+		}
+		return requests[i].status < requests[j].status
+	})
+
+	name := metrics.namespace + "ovh_requests_total"
+	fmt.Fprintf(out, "# HELP %s Calls made to the OVH API.\n# TYPE %s counter\n", name, name)
+	for _, key := range requests {
+		fmt.Fprintf(out, "%s{method=%s,route=%s,status=%s} %d\n",
+			name, promLabel(key.method), promLabel(key.route), promLabel(key.status), metrics.counts[key])
+	}
+
+	routes := make([]promRouteKey, 0, len(metrics.histograms))
+	for key := range metrics.histograms {
+		routes = append(routes, key)
+	}
+	sort.Slice(routes, func(i, j int) bool { return routes[i].less(routes[j]) })
+
+	name = metrics.namespace + "ovh_request_duration_seconds"
+	fmt.Fprintf(out, "# HELP %s Duration of the calls made to the OVH API.\n# TYPE %s histogram\n", name, name)
+	for _, key := range routes {
+		histogram := metrics.histograms[key]
+		labels := fmt.Sprintf("method=%s,route=%s", promLabel(key.method), promLabel(key.route))
+		for i, bound := range metrics.buckets {
+			fmt.Fprintf(out, "%s_bucket{%s,le=%s} %d\n", name, labels, promLabel(strconv.FormatFloat(bound, 'g', -1, 64)), histogram.counts[i])
+		}
+		fmt.Fprintf(out, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, labels, histogram.count)
+		fmt.Fprintf(out, "%s_sum{%s} %s\n", name, labels, strconv.FormatFloat(histogram.sum, 'g', -1, 64))
+		fmt.Fprintf(out, "%s_count{%s} %d\n", name, labels, histogram.count)
+	}
+
+	if err := buffered.Flush(); err != nil {
+		return out.n, err
+	}
+	return out.n, out.err
+}
+
+func (key promRouteKey) less(other promRouteKey) bool {
+	if key.route != other.route {
+		return key.route < other.route
+	}
+	return key.method < other.method
+}
+
+// promLabel quotes a label value.
+func promLabel(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, `"`, `\"`, -1)
+	value = strings.Replace(value, "\n", `\n`, -1)
+	return `"` + value + `"`
+}
+
+// countingWriter counts the bytes written, and keeps the first error.
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
@@ -0,0 +1,119 @@
+package govh
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QueryFromStruct builds query parameters from a struct, using its `url`
+// field tags:
+//
+//	type RecordFilter struct {
+//		FieldType string `url:"fieldType,omitempty"`
+//		SubDomain string `url:"subDomain,omitempty"`
+//	}
+//
+// Fields without tag are named after the field, fields tagged "-" are skipped
+// and "omitempty" skips zero values. Slices add one value per element.
+func QueryFromStruct(params interface{}) (url.Values, error) {
+	query := url.Values{}
+	if params == nil {
+		return query, nil
+	}
+
+	v := reflect.ValueOf(params)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return query, nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("Query parameters must be a struct, got %s", v.Kind())
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name, omitEmpty := field.Name, false
+		if tag, ok := field.Tag.Lookup("url"); ok {
+			parts := strings.Split(tag, ",")
+			if parts[0] == "-" {
+				continue
+			}
+			if parts[0] != "" {
+				name = parts[0]
+			}
+			for _, opt := range parts[1:] {
+				if opt == "omitempty" {
+					omitEmpty = true
+				}
+			}
+		}
+
+		fv := v.Field(i)
+		if omitEmpty && fv.IsZero() {
+			continue
+		}
+		for fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				break
+			}
+			fv = fv.Elem()
+		}
+		if fv.Kind() == reflect.Ptr {
+			continue
+		}
+
+		if fv.Kind() == reflect.Slice || fv.Kind() == reflect.Array {
+			for j := 0; j < fv.Len(); j++ {
+				s, err := formatQueryValue(fv.Index(j))
+				if err != nil {
+					return nil, fmt.Errorf("Query parameter %q: %s", name, err)
+				}
+				query.Add(name, s)
+			}
+			continue
+		}
+
+		s, err := formatQueryValue(fv)
+		if err != nil {
+			return nil, fmt.Errorf("Query parameter %q: %s", name, err)
+		}
+		query.Add(name, s)
+	}
+
+	return query, nil
+}
+
+func formatQueryValue(v reflect.Value) (string, error) {
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339), nil
+	}
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String(), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
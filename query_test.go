@@ -0,0 +1,57 @@
+package govh
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+func TestQueryFromStruct(t *testing.T) {
+	type filter struct {
+		FieldType string   `url:"fieldType,omitempty"`
+		SubDomain string   `url:"subDomain,omitempty"`
+		Zone      string   `url:"-"`
+		Limit     int      `url:"limit"`
+		Tags      []string `url:"tag"`
+	}
+
+	query, err := QueryFromStruct(&filter{FieldType: "A", Zone: "example.com", Tags: []string{"a", "b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if encoded := query.Encode(); encoded != "fieldType=A&limit=0&tag=a&tag=b" {
+		t.Fatalf("unexpected query: %s", encoded)
+	}
+
+	if _, err := QueryFromStruct(42); err == nil {
+		t.Fatal("expected an error for a non-struct value")
+	}
+}
+
+func TestCallAPIWithQuery(t *testing.T) {
+	c := &Caller{ApplicationSecret: "secret", ConsumerKey: "ck"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("subDomain") != "www idn" {
+			t.Errorf("unexpected query %q", r.URL.RawQuery)
+		}
+
+		timestamp, _ := strconv.ParseInt(r.Header.Get("X-Ovh-Timestamp"), 10, 64)
+		sig := c.getSignature(c.ConsumerKey, r.Method, c.URL+r.URL.RequestURI(), "", timestamp)
+		if sig != r.Header.Get("X-Ovh-Signature") {
+			t.Error("query is not part of the signature")
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+	c.URL = server.URL
+
+	query := url.Values{"fieldType": {"A"}, "subDomain": {"www idn"}}
+	if err := c.GetWithContext(context.Background(), "/domain/zone/example.com/record", nil, WithQuery(query)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,63 @@
+package govh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Run with -race to check Caller is safe for concurrent use.
+func TestConcurrentCalls(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/time":
+			fmt.Fprint(w, time.Now().Unix())
+		case "/auth/credential":
+			w.Write([]byte(`{"consumerKey":"newck","state":"pendingValidation"}`))
+		default:
+			w.Write([]byte(`{"name":"ovh"}`))
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewLazyCaller(server.URL, "ak", "as", "ck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.TimeSyncInterval = time.Millisecond
+	c.RateLimiter = NewRateLimiter(1000, 100)
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(4)
+		go func() {
+			defer wg.Done()
+			var me struct{ Name string }
+			if err := c.GetWithContext(ctx, "/me", &me); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := c.SyncTimeWithContext(ctx); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func(i int) {
+			defer wg.Done()
+			c.SetConsumerKey(fmt.Sprintf("ck%d", i))
+		}(i)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetConsumerKeyWithContext(ctx, &GetCKParams{}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,76 @@
+package govh
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket limiting the rate of calls made to the API.
+// It is safe for concurrent use, and may be shared between several callers.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a limiter allowing rate requests per second on
+// average, with bursts of at most burst requests.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed, or until ctx is done.
+func (limiter *RateLimiter) Wait(ctx context.Context) error {
+	_, err := limiter.wait(ctx)
+	return err
+}
+
+// wait is like Wait, also reporting whether the request was delayed.
+func (limiter *RateLimiter) wait(ctx context.Context) (bool, error) {
+	for waited := false; ; waited = true {
+		d := limiter.reserve()
+		if d == 0 {
+			return waited, nil
+		}
+		if err := sleepContext(ctx, d); err != nil {
+			return waited, err
+		}
+	}
+}
+
+// reserve takes a token if one is available, otherwise it returns how long to
+// wait before the next one.
+func (limiter *RateLimiter) reserve() time.Duration {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if limiter.rate <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	limiter.tokens += now.Sub(limiter.last).Seconds() * limiter.rate
+	if limiter.tokens > limiter.burst {
+		limiter.tokens = limiter.burst
+	}
+	limiter.last = now
+
+	if limiter.tokens >= 1 {
+		limiter.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - limiter.tokens) / limiter.rate * float64(time.Second))
+}
@@ -0,0 +1,28 @@
+package govh
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrReadOnly matches errors returned by read-only callers for calls that
+// may modify resources.
+var ErrReadOnly = errors.New("govh: read-only caller")
+
+// ReadOnlyError is returned by a read-only caller asked to perform a call
+// other than GET. It matches ErrReadOnly with errors.Is.
+type ReadOnlyError struct {
+	// HTTP method of the rejected call.
+	Method string
+	// Path of the rejected call.
+	Path string
+}
+
+func (err *ReadOnlyError) Error() string {
+	return fmt.Sprintf("govh: read-only caller can't perform %s %s", err.Method, err.Path)
+}
+
+// Is makes ReadOnlyError match ErrReadOnly.
+func (err *ReadOnlyError) Is(target error) bool {
+	return target == ErrReadOnly
+}
@@ -0,0 +1,52 @@
+package govh
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReadOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("%s call reached the API", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, ReadOnly: true}
+
+	if err := c.Get("/me", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, method := range []string{"POST", "PUT", "DELETE", "post"} {
+		err := c.CallAPI("/me", method, nil, nil)
+
+		var readOnlyErr *ReadOnlyError
+		if !errors.Is(err, ErrReadOnly) || !errors.As(err, &readOnlyErr) || readOnlyErr.Method != method {
+			t.Fatalf("expected a read-only error for %s, got %v", method, err)
+		}
+	}
+}
+
+func TestReadOnlyConsumerKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("%s %s reached the API", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, ApplicationKey: "ak", ApplicationSecret: "as", ReadOnly: true}
+	if _, err := c.GetConsumerKey(&GetCKParams{}); !errors.Is(err, ErrReadOnly) {
+		t.Fatalf("expected a read-only error, got %v", err)
+	}
+
+	c = &Caller{URL: server.URL, ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "ck", DryRun: true}
+	if _, err := c.GetConsumerKey(&GetCKParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if c.ConsumerKey != "ck" {
+		t.Fatalf("expected the consumer key to be kept, got %q", c.ConsumerKey)
+	}
+}
@@ -0,0 +1,139 @@
+package govh
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Registry holds callers for several endpoints or accounts, keyed by alias:
+//
+//	clients := govh.NewRegistry()
+//	caller, err := clients.For("ovh-ca")
+//	...
+//	err = caller.Get("/me", &me)
+//
+// Callers are built on first use. Aliases that are not registered are read
+// from the configuration files, from the section named after the alias:
+//
+//	[ovh-eu]
+//	application_key=...
+//
+//	[customer-ca]
+//	endpoint=ovh-ca
+//	application_key=...
+//
+// It is safe for concurrent use.
+type Registry struct {
+	// Configuration files read for unregistered aliases, ConfigPaths if
+	// empty.
+	ConfigPaths []string
+
+	mu      sync.Mutex
+	options []Option
+	entries map[string]*registryEntry
+}
+
+type registryEntry struct {
+	mu     sync.Mutex
+	build  func() (*Caller, error)
+	caller *Caller
+	// Whether the entry was added by For, for an alias of the
+	// configuration files.
+	fromConfig bool
+}
+
+// NewRegistry creates a registry. The options are applied to every caller
+// built by the registry, after the ones of the configuration.
+func NewRegistry(opts ...Option) *Registry {
+	return &Registry{
+		options: opts,
+		entries: make(map[string]*registryEntry),
+	}
+}
+
+// Register registers a caller for endpoint under alias, built with opts
+// after the options of the registry. It replaces any caller registered for
+// alias.
+func (registry *Registry) Register(alias, endpoint string, opts ...Option) {
+	registry.set(alias, &registryEntry{build: func() (*Caller, error) {
+		return NewClient(endpoint, append(append([]Option{}, registry.options...), opts...)...)
+	}})
+}
+
+// Set registers an already built caller under alias.
+func (registry *Registry) Set(alias string, caller *Caller) {
+	registry.set(alias, &registryEntry{caller: caller})
+}
+
+func (registry *Registry) set(alias string, entry *registryEntry) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.entries[alias] = entry
+}
+
+// For returns the caller registered under alias, building it if needed.
+// A failed construction is retried on the next call. Aliases that are
+// neither registered nor found in the configuration files are forgotten.
+func (registry *Registry) For(alias string) (*Caller, error) {
+	registry.mu.Lock()
+	entry, ok := registry.entries[alias]
+	if !ok {
+		entry = &registryEntry{fromConfig: true, build: func() (*Caller, error) {
+			return registry.fromConfig(alias)
+		}}
+		registry.entries[alias] = entry
+	}
+	registry.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.caller == nil {
+		caller, err := entry.build()
+		if err != nil {
+			if entry.fromConfig {
+				registry.forget(alias, entry)
+			}
+			return nil, err
+		}
+		entry.caller = caller
+	}
+	return entry.caller, nil
+}
+
+// forget removes the entry of alias, unless it was replaced meanwhile.
+func (registry *Registry) forget(alias string, entry *registryEntry) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if registry.entries[alias] == entry {
+		delete(registry.entries, alias)
+	}
+}
+
+// Aliases returns the aliases registered or used so far, sorted.
+func (registry *Registry) Aliases() []string {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	aliases := make([]string, 0, len(registry.entries))
+	for alias := range registry.entries {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+func (registry *Registry) fromConfig(alias string) (*Caller, error) {
+	config, err := LoadEndpointConfig(alias, registry.ConfigPaths...)
+	if err != nil {
+		return nil, err
+	}
+	if config.ApplicationKey == "" && config.ClientID == "" {
+		return nil, fmt.Errorf("No credentials found for %q", alias)
+	}
+
+	return NewClient(config.Endpoint, append(config.Options(), registry.options...)...)
+}
@@ -0,0 +1,70 @@
+package govh
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "govh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "ovh.conf")
+	ioutil.WriteFile(path, []byte(`
+[ovh-eu]
+application_key=euak
+application_secret=euas
+
+[customer-ca]
+endpoint=ovh-ca
+application_key=caak
+application_secret=caas
+consumer_key=cack
+`), 0600)
+
+	registry := NewRegistry(WithoutTimeSync())
+	registry.ConfigPaths = []string{path}
+	registry.Register("kimsufi", "kimsufi-eu", WithCredentials("ksak", "ksas", "ksck"))
+
+	eu, err := registry.For("ovh-eu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if eu.URL != APIURL["ovh-eu"] || eu.ApplicationKey != "euak" {
+		t.Fatalf("unexpected caller %s %s", eu.URL, eu.ApplicationKey)
+	}
+	if again, _ := registry.For("ovh-eu"); again != eu {
+		t.Fatal("expected the caller to be built once")
+	}
+
+	ca, err := registry.For("customer-ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ca.URL != APIURL["ovh-ca"] || ca.ConsumerKey != "cack" {
+		t.Fatalf("unexpected caller %s %s", ca.URL, ca.ConsumerKey)
+	}
+
+	ks, err := registry.For("kimsufi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ks.ApplicationKey != "ksak" {
+		t.Fatalf("unexpected caller %s", ks.ApplicationKey)
+	}
+
+	if _, err := registry.For("unknown"); err == nil {
+		t.Fatal("expected an error for an unknown alias")
+	}
+
+	expected := []string{"customer-ca", "kimsufi", "ovh-eu"}
+	if aliases := registry.Aliases(); !reflect.DeepEqual(aliases, expected) {
+		t.Fatalf("unexpected aliases %v", aliases)
+	}
+}
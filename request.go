@@ -0,0 +1,197 @@
+package govh
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// authHeaders are the headers set by the caller to authenticate requests.
+var authHeaders = map[string]bool{
+	"Authorization":     true,
+	"X-Ovh-Application": true,
+	"X-Ovh-Consumer":    true,
+	"X-Ovh-Timestamp":   true,
+	"X-Ovh-Signature":   true,
+}
+
+// execute performs a call, handling time synchronization and retries.
+// Unless stream is set and the call succeeded, the response body is fully
+// read, closed and returned.
+func (caller *Caller) execute(ctx context.Context, options *callOptions, url, method string, body interface{}, stream bool) (result *http.Response, resBody []byte, err error) {
+	start := time.Now()
+	defer func() {
+		duration := time.Since(start)
+		caller.stats.recordCall(result, err, duration)
+		caller.observeCall(options, method, url, result, err, duration)
+	}()
+
+	ctx, endSpan := caller.startSpan(ctx, options, method, url)
+	defer func() { endSpan(result, err) }()
+
+	if caller.ReadOnly && method != "GET" {
+		return nil, nil, &ReadOnlyError{Method: method, Path: url}
+	}
+
+	params, contentType, err := encodeBody(body)
+	if err != nil {
+		return nil, nil, err
+	}
+	options.contentType = contentType
+
+	if caller.Validator != nil {
+		if err := caller.validateRequest(options, method, url, params); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if caller.Audit != nil {
+		defer func() {
+			caller.audit(method, url, params, result, err, start)
+		}()
+	}
+
+	completeURL := options.buildURL(caller.URL, url)
+
+	if isClockSensitive(caller.authProvider(options)) {
+		if err := caller.ensureTimeSync(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if caller.DryRun && !isSafeMethod(method) {
+		result, err := caller.dryRun(ctx, options, method, completeURL, params)
+		return result, nil, err
+	}
+
+	if caller.CoalesceRequests && method == "GET" && !stream {
+		return caller.flights.do(caller.coalesceKey(options, completeURL), func() (*http.Response, []byte, error) {
+			return caller.executeCached(ctx, options, method, completeURL, params, stream)
+		})
+	}
+
+	return caller.executeCached(ctx, options, method, completeURL, params, stream)
+}
+
+// executeCached performs a call, going through the cache if any.
+func (caller *Caller) executeCached(ctx context.Context, options *callOptions, method, completeURL string, params []byte, stream bool) (*http.Response, []byte, error) {
+	cacheKey, cached := caller.lookupCache(options, method, completeURL, stream)
+
+	result, resBody, err := caller.executeWithRetries(ctx, options, method, completeURL, params, stream)
+	if err == nil && cacheKey != "" {
+		result, resBody = caller.updateCache(cacheKey, cached, result, resBody)
+	}
+
+	return result, resBody, err
+}
+
+// executeWithRetries performs a call until it succeeds or the retry policy
+// gives up.
+func (caller *Caller) executeWithRetries(ctx context.Context, options *callOptions, method, completeURL string, params []byte, stream bool) (*http.Response, []byte, error) {
+	resynced := false
+	for attempt := 0; ; attempt++ {
+		options.attempts++
+		result, err := caller.send(ctx, options, method, completeURL, params)
+
+		var resBody []byte
+		if err == nil && (!stream || !isSuccess(result.StatusCode)) {
+			resBody, err = ioutil.ReadAll(result.Body)
+			result.Body.Close()
+		}
+
+		// The API rejected the request timestamp: synchronize time once
+		// and try again, without counting it as a retry. The rejected
+		// request was not applied, so this is safe for any method.
+		if err == nil && !resynced && isClockSensitive(caller.authProvider(options)) && isTimeSkewResponse(result, resBody) {
+			resynced = true
+			if caller.Logger != nil {
+				caller.Logger.Printf("govh: %s %s: request timestamp rejected, synchronizing time", method, completeURL)
+			}
+			if err := caller.SyncTimeWithContext(ctx); err != nil {
+				return nil, nil, err
+			}
+			attempt--
+			continue
+		}
+
+		if !caller.Retry.shouldRetry(ctx, attempt, method, result, err) {
+			return result, resBody, err
+		}
+		if err := sleepContext(ctx, caller.Retry.delay(attempt, result)); err != nil {
+			return nil, nil, err
+		}
+		caller.stats.recordRetry()
+	}
+}
+
+// send signs and performs a single request.
+func (caller *Caller) send(ctx context.Context, options *callOptions, method, completeURL string, params []byte) (*http.Response, error) {
+	if err := caller.CircuitBreaker.allow(ctx, caller.PingWithContext); err != nil {
+		return nil, err
+	}
+
+	if caller.RateLimiter != nil {
+		waited, err := caller.RateLimiter.wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if waited {
+			caller.stats.recordRateLimiterWait()
+		}
+	}
+
+	request, err := caller.newRequest(ctx, options, method, completeURL, params)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	result, err := caller.roundTrip()(request)
+	duration := time.Since(start)
+	caller.CircuitBreaker.record(ctx, result, err)
+	if err == nil {
+		caller.stats.recordResponse(result)
+		err = decompress(result)
+	}
+	caller.logRequest(request, params, result, err, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// newRequest builds and signs the request of a call.
+func (caller *Caller) newRequest(ctx context.Context, options *callOptions, method, completeURL string, params []byte) (*http.Request, error) {
+	request, err := http.NewRequestWithContext(ctx, method, completeURL, bytes.NewReader(params))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Content-Type", options.contentType)
+	request.Header.Set("User-Agent", caller.userAgent())
+	for h, values := range options.header {
+		if !authHeaders[h] {
+			request.Header[h] = values
+		}
+	}
+
+	if err := caller.authProvider(options).SignRequest(request, params, caller.now()); err != nil {
+		return nil, err
+	}
+
+	if caller.DisableCompression {
+		request.Header.Set("Accept-Encoding", "identity")
+	} else {
+		request.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+
+	return request, nil
+}
+
+// isSuccess tells whether statusCode is a 2xx code.
+func isSuccess(statusCode int) bool {
+	return statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices
+}
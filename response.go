@@ -0,0 +1,33 @@
+package govh
+
+import (
+	"net/http"
+	"time"
+)
+
+// Response holds the details of an HTTP response returned by the API.
+type Response struct {
+	// HTTP status code.
+	StatusCode int
+	// Response headers.
+	Header http.Header
+	// Raw response body.
+	Body []byte
+	// Unique identifier of the request, given by X-Ovh-QueryID header.
+	// It should be given to OVH support when reporting an issue.
+	QueryID string
+	// Duration of the call, including retries.
+	Duration time.Duration
+	// Requests sent for the call, including retries. It is zero for calls
+	// which were not sent, e.g. in dry-run mode or coalesced with another.
+	Attempts int
+}
+
+func newResponse(result *http.Response, body []byte) *Response {
+	return &Response{
+		StatusCode: result.StatusCode,
+		Header:     result.Header,
+		Body:       body,
+		QueryID:    result.Header.Get("X-Ovh-QueryID"),
+	}
+}
@@ -0,0 +1,137 @@
+package govh
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy describes how CallAPI retries transient failures: network
+// errors, 5xx responses and 429 rate-limit responses. When the API answers
+// with a Retry-After header, it is honored instead of the backoff, up to
+// MaxRetryAfter.
+type RetryPolicy struct {
+	// Maximum number of retries after the first attempt.
+	MaxRetries int
+	// Backoff before the first retry. It doubles after each attempt.
+	MinBackoff time.Duration
+	// Upper bound for the backoff between two attempts.
+	MaxBackoff time.Duration
+	// Upper bound for the delay asked by a Retry-After header,
+	// DefaultMaxRetryAfter if zero.
+	MaxRetryAfter time.Duration
+	// Also retry non-idempotent calls (POST).
+	// Those may be applied twice by the API if a response is lost.
+	RetryNonIdempotent bool
+}
+
+// DefaultMaxRetryAfter is the longest delay asked by a Retry-After header
+// honored by a RetryPolicy without MaxRetryAfter.
+const DefaultMaxRetryAfter = time.Minute
+
+// DefaultRetryPolicy returns a policy retrying idempotent calls up to 3 times,
+// waiting between 500ms and 10s.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// shouldRetry tells whether the call that ended with result or err deserves
+// another attempt.
+func (policy *RetryPolicy) shouldRetry(ctx context.Context, attempt int, method string, result *http.Response, err error) bool {
+	if policy == nil || attempt >= policy.MaxRetries || ctx.Err() != nil {
+		return false
+	}
+
+	if !policy.RetryNonIdempotent && !isIdempotent(method) {
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, ErrCircuitOpen)
+	}
+
+	return result.StatusCode == http.StatusTooManyRequests || result.StatusCode >= http.StatusInternalServerError
+}
+
+// backoff returns the delay before retry number attempt+1, using exponential
+// backoff with full jitter.
+func (policy *RetryPolicy) backoff(attempt int) time.Duration {
+	d := policy.MinBackoff
+	for i := 0; i < attempt && d < policy.MaxBackoff; i++ {
+		d *= 2
+	}
+	if policy.MaxBackoff > 0 && d > policy.MaxBackoff {
+		d = policy.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(d)) + 1)
+}
+
+// delay returns how long to wait before retry number attempt+1, after
+// result.
+func (policy *RetryPolicy) delay(attempt int, result *http.Response) time.Duration {
+	if result != nil {
+		if d := retryAfter(result.Header, time.Now()); d > 0 {
+			max := policy.MaxRetryAfter
+			if max <= 0 {
+				max = DefaultMaxRetryAfter
+			}
+			if d > max {
+				d = max
+			}
+			return d
+		}
+	}
+	return policy.backoff(attempt)
+}
+
+// retryAfter returns the delay given by the Retry-After header, either in
+// seconds or as an HTTP date, or zero if there is none.
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	value := strings.TrimSpace(header.Get("Retry-After"))
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE", "OPTIONS":
+		return true
+	}
+	return false
+}
+
+// sleepContext waits for d, or returns early with ctx error.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,118 @@
+package govh
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransientFailures(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"message":"Service unavailable"}`))
+			return
+		}
+		w.Write([]byte(`{"name":"ovh"}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{
+		URL:   server.URL,
+		Retry: &RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond},
+	}
+
+	var me struct{ Name string }
+	if err := c.CallAPI("/me", "GET", nil, &me); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 || me.Name != "ovh" {
+		t.Fatalf("unexpected result after %d calls: %+v", calls, me)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	if err := c.CallAPI("/me", "POST", nil, nil); err == nil {
+		t.Fatal("expected POST to fail without retry")
+	}
+	if calls != 1 {
+		t.Fatalf("POST should not be retried, got %d calls", calls)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: 10 * time.Millisecond, MaxBackoff: 40 * time.Millisecond}
+	for attempt := 0; attempt < 10; attempt++ {
+		if d := policy.backoff(attempt); d <= 0 || d > 40*time.Millisecond {
+			t.Fatalf("backoff %d out of bounds: %s", attempt, d)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	for value, expected := range map[string]time.Duration{
+		"":                              0,
+		"3":                             3 * time.Second,
+		"-1":                            0,
+		"Fri, 01 Mar 2024 10:00:30 GMT": 30 * time.Second,
+		"Fri, 01 Mar 2024 09:00:00 GMT": 0,
+		"soon":                          0,
+	} {
+		header := http.Header{}
+		header.Set("Retry-After", value)
+		if d := retryAfter(header, now); d != expected {
+			t.Errorf("Retry-After %q: expected %s, got %s", value, expected, d)
+		}
+	}
+}
+
+func TestRetryAfterClamp(t *testing.T) {
+	result := &http.Response{Header: http.Header{}}
+	result.Header.Set("Retry-After", "86400")
+
+	policy := &RetryPolicy{MaxBackoff: time.Second}
+	if d := policy.delay(0, result); d != DefaultMaxRetryAfter {
+		t.Fatalf("expected Retry-After to be clamped to %s, got %s", DefaultMaxRetryAfter, d)
+	}
+	policy.MaxRetryAfter = 5 * time.Second
+	if d := policy.delay(0, result); d != 5*time.Second {
+		t.Fatalf("expected Retry-After to be clamped to 5s, got %s", d)
+	}
+	result.Header.Set("Retry-After", "2")
+	if d := policy.delay(0, result); d != 2*time.Second {
+		t.Fatalf("expected Retry-After to be honored, got %s", d)
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message":"Too many requests"}`))
+			return
+		}
+		w.Write([]byte(`null`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+	err := c.CallAPI("/me", "GET", nil, nil)
+	if apiError, ok := err.(*ApiOvhError); !ok || apiError.RetryAfter != time.Second {
+		t.Fatalf("expected an error with Retry-After, got %v", err)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	c.Retry = &RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	start := time.Now()
+	if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected to wait for Retry-After, retried after %s", elapsed)
+	}
+}
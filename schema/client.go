@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"context"
+	"strings"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Client fetches the descriptions of the APIs of an endpoint.
+type Client struct {
+	api govh.Client
+}
+
+// New returns a Client calling the API with client, usually a *govh.Caller.
+// Descriptions are public: they are fetched without authentication.
+func New(client govh.Client) *Client {
+	return &Client{api: client}
+}
+
+// Index returns the list of the APIs of the endpoint.
+func (client *Client) Index(ctx context.Context) (*Index, error) {
+	var index Index
+	if err := client.api.GetWithContext(ctx, "/", &index, govh.Unauthenticated()); err != nil {
+		return nil, err
+	}
+	return &index, nil
+}
+
+// API returns the description of an API, given its name, e.g. "domain" or
+// "dedicated/server", or its path, e.g. "/domain".
+func (client *Client) API(ctx context.Context, name string) (*API, error) {
+	var api API
+	path := "/" + strings.Trim(name, "/") + ".json"
+	if err := client.api.GetWithContext(ctx, path, &api, govh.Unauthenticated()); err != nil {
+		return nil, err
+	}
+	return &api, nil
+}
@@ -0,0 +1,219 @@
+// Package schema reads the self-description of the OVH API: the routes of
+// each API, such as /domain, their parameters, and the models of their
+// bodies and responses.
+//
+//	client := schema.New(caller)
+//	api, err := client.API(ctx, "domain")
+//	route, operation := api.Operation("GET", "/domain/zone/example.com")
+package schema
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+// Index lists the APIs described by an endpoint, as returned by GET /.
+type Index struct {
+	APIVersion string        `json:"apiVersion"`
+	BasePath   string        `json:"basePath"`
+	APIs       []*IndexEntry `json:"apis"`
+}
+
+// IndexEntry is an API of the index.
+type IndexEntry struct {
+	// Path of the API, e.g. "/domain".
+	Path        string `json:"path"`
+	Description string `json:"description"`
+	// Path of the description, e.g. "/domain.{format}".
+	Schema string `json:"schema"`
+}
+
+// Name returns the name of the API, e.g. "domain", as given to Client.API.
+func (entry *IndexEntry) Name() string {
+	return strings.TrimPrefix(entry.Path, "/")
+}
+
+// API is the description of an API, as returned by GET /{api}.json.
+type API struct {
+	APIVersion   string            `json:"apiVersion"`
+	ResourcePath string            `json:"resourcePath"`
+	BasePath     string            `json:"basePath"`
+	Routes       []*Route          `json:"apis"`
+	Models       map[string]*Model `json:"models"`
+}
+
+// Route is a path of an API, with the operations it supports.
+type Route struct {
+	// Path template, e.g. "/domain/zone/{zoneName}".
+	Path        string       `json:"path"`
+	Description string       `json:"description"`
+	Operations  []*Operation `json:"operations"`
+}
+
+// Operation is a method supported by a route.
+type Operation struct {
+	HTTPMethod       string `json:"httpMethod"`
+	Description      string `json:"description"`
+	NoAuthentication bool   `json:"noAuthentication"`
+	// Type of the response, a primitive such as "string", a model name such
+	// as "domain.zone.Zone", or an array of those such as "string[]".
+	ResponseType string       `json:"responseType"`
+	APIStatus    *Status      `json:"apiStatus"`
+	Parameters   []*Parameter `json:"parameters"`
+	IAMActions   []*IAMAction `json:"iamActions"`
+}
+
+// Status is the lifecycle status of an operation.
+type Status struct {
+	// BETA, PRODUCTION, DEPRECATED or DELETED.
+	Value       string `json:"value"`
+	Description string `json:"description"`
+	// Dates of deprecation and deletion, for deprecated operations.
+	DeprecatedDate string `json:"deprecatedDate,omitempty"`
+	DeletionDate   string `json:"deletionDate,omitempty"`
+	// Operation replacing a deprecated one.
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// Parameter is a parameter of an operation.
+type Parameter struct {
+	// Name of the parameter. It is empty for a body described by a model.
+	Name string `json:"name"`
+	// Where the parameter goes: path, query or body.
+	ParamType   string      `json:"paramType"`
+	DataType    string      `json:"dataType"`
+	FullType    string      `json:"fullType"`
+	Required    bool        `json:"required"`
+	Description string      `json:"description"`
+	Default     interface{} `json:"default,omitempty"`
+}
+
+// IAMAction is an IAM action required to call an operation.
+type IAMAction struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+}
+
+// Model is a type of the API: either an object with properties, or an enum.
+type Model struct {
+	ID          string `json:"id"`
+	Namespace   string `json:"namespace"`
+	Description string `json:"description"`
+	// Properties of an object model, by name.
+	Properties map[string]*Property `json:"properties"`
+	// Type parameters of a generic model, e.g. ["T"].
+	Generics []string `json:"generics,omitempty"`
+	// Values of an enum model, and their type.
+	EnumType string   `json:"enumType,omitempty"`
+	Enum     []string `json:"enum,omitempty"`
+}
+
+// IsEnum tells whether the model is an enum.
+func (model *Model) IsEnum() bool {
+	return model.Enum != nil
+}
+
+// Property is a property of an object model.
+type Property struct {
+	Type        string `json:"type"`
+	FullType    string `json:"fullType"`
+	Description string `json:"description"`
+	CanBeNull   bool   `json:"canBeNull"`
+	ReadOnly    bool   `json:"readOnly"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+// Parse decodes the description of an API.
+func Parse(r io.Reader) (*API, error) {
+	var api API
+	if err := json.NewDecoder(r).Decode(&api); err != nil {
+		return nil, err
+	}
+	return &api, nil
+}
+
+// Route returns the route of the API matching path, either a concrete path
+// such as "/domain/zone/example.com", or a template such as
+// "/domain/zone/{zoneName}". Routes without parameters at a position take
+// precedence, so that "/domain/zone/export" doesn't match
+// "/domain/zone/{zoneName}" if it is a route of its own.
+func (api *API) Route(path string) *Route {
+	segments := strings.Split(path, "/")
+
+	var best *Route
+	bestScore := -1
+	for _, route := range api.Routes {
+		score, ok := matchPath(strings.Split(route.Path, "/"), segments)
+		if ok && score > bestScore {
+			best, bestScore = route, score
+		}
+	}
+	return best
+}
+
+// Operation returns the route matching path, and its operation for method.
+// The operation is nil if the route doesn't support method.
+func (api *API) Operation(method, path string) (*Route, *Operation) {
+	route := api.Route(path)
+	if route == nil {
+		return nil, nil
+	}
+	return route, route.Operation(method)
+}
+
+// Model returns the model with the given name, e.g. "domain.zone.Zone", or
+// nil. Names of generic models may carry type arguments, such as
+// "complexType.UnitAndValue<double>".
+func (api *API) Model(name string) *Model {
+	if i := strings.IndexByte(name, '<'); i >= 0 {
+		name = name[:i]
+	}
+	return api.Models[name]
+}
+
+// Operation returns the operation of the route for method, or nil.
+func (route *Route) Operation(method string) *Operation {
+	for _, operation := range route.Operations {
+		if strings.EqualFold(operation.HTTPMethod, method) {
+			return operation
+		}
+	}
+	return nil
+}
+
+// PathParameters returns the names of the parameters of the route path, in
+// order.
+func (route *Route) PathParameters() []string {
+	var names []string
+	for _, segment := range strings.Split(route.Path, "/") {
+		if isParameter(segment) {
+			names = append(names, segment[1:len(segment)-1])
+		}
+	}
+	return names
+}
+
+// matchPath tells whether a path matches a template, scoring the match by its
+// number of literal segments.
+func matchPath(template, segments []string) (int, bool) {
+	if len(template) != len(segments) {
+		return 0, false
+	}
+
+	score := 0
+	for i, segment := range template {
+		switch {
+		case segment == segments[i]:
+			score++
+		case isParameter(segment) && segments[i] != "":
+		default:
+			return 0, false
+		}
+	}
+	return score, true
+}
+
+func isParameter(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
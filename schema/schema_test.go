@@ -0,0 +1,157 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+const domainSchema = `{
+  "apiVersion": "1.0",
+  "resourcePath": "/domain",
+  "basePath": "https://eu.api.ovh.com/1.0",
+  "apis": [
+    {
+      "path": "/domain/zone/{zoneName}",
+      "description": "Zone dns Management",
+      "operations": [
+        {
+          "httpMethod": "GET",
+          "description": "Get this object properties",
+          "noAuthentication": false,
+          "responseType": "domain.zone.Zone",
+          "apiStatus": {"value": "PRODUCTION", "description": "Stable production version"},
+          "parameters": [
+            {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true, "description": "The internal name of your zone"}
+          ],
+          "iamActions": [{"name": "dnsZone:apiovh:get", "required": true}]
+        }
+      ]
+    },
+    {
+      "path": "/domain/zone/{zoneName}/record",
+      "description": "List the domain.zone.Record objects",
+      "operations": [
+        {
+          "httpMethod": "POST",
+          "description": "Create a new DNS record",
+          "responseType": "domain.zone.Record",
+          "apiStatus": {"value": "PRODUCTION", "description": "Stable production version"},
+          "parameters": [
+            {"name": "fieldType", "paramType": "body", "dataType": "zone.NamedResolutionFieldTypeEnum", "fullType": "zone.NamedResolutionFieldTypeEnum", "required": true, "description": "Resource record Name"},
+            {"name": "target", "paramType": "body", "dataType": "string", "fullType": "string", "required": true, "description": "Resource record target"},
+            {"name": "zoneName", "paramType": "path", "dataType": "string", "fullType": "string", "required": true, "description": "The internal name of your zone"}
+          ]
+        }
+      ]
+    },
+    {
+      "path": "/domain/zone/{zoneName}/export",
+      "description": "Export zone",
+      "operations": [
+        {"httpMethod": "GET", "description": "Export zone", "responseType": "text", "apiStatus": {"value": "PRODUCTION", "description": "Stable production version"}, "parameters": []}
+      ]
+    }
+  ],
+  "models": {
+    "domain.zone.Zone": {
+      "id": "Zone",
+      "namespace": "domain.zone",
+      "description": "Zone dns Management",
+      "properties": {
+        "name": {"type": "string", "fullType": "string", "canBeNull": false, "readOnly": true, "description": "Zone name"},
+        "nameServers": {"type": "string[]", "fullType": "string[]", "canBeNull": false, "readOnly": true, "description": "Name servers that host the DNS zone"}
+      }
+    },
+    "zone.NamedResolutionFieldTypeEnum": {
+      "id": "NamedResolutionFieldTypeEnum",
+      "namespace": "zone",
+      "description": "Resource record fieldType",
+      "enumType": "string",
+      "enum": ["A", "AAAA", "CNAME", "MX", "TXT"]
+    },
+    "complexType.UnitAndValue": {
+      "id": "UnitAndValue",
+      "namespace": "complexType",
+      "description": "A numeric value tagged with its unit",
+      "generics": ["T"],
+      "properties": {
+        "unit": {"type": "string", "fullType": "string", "canBeNull": false, "readOnly": false},
+        "value": {"type": "T", "fullType": "T", "canBeNull": false, "readOnly": false}
+      }
+    }
+  }
+}`
+
+func TestParse(t *testing.T) {
+	api, err := Parse(strings.NewReader(domainSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	route, operation := api.Operation("get", "/domain/zone/example.com")
+	if route == nil || route.Path != "/domain/zone/{zoneName}" || operation == nil || operation.ResponseType != "domain.zone.Zone" {
+		t.Fatalf("unexpected route %+v", route)
+	}
+	if params := route.PathParameters(); len(params) != 1 || params[0] != "zoneName" {
+		t.Fatalf("unexpected path parameters %v", params)
+	}
+	if operation.APIStatus.Value != "PRODUCTION" || len(operation.IAMActions) != 1 {
+		t.Fatalf("unexpected operation %+v", operation)
+	}
+
+	if route := api.Route("/domain/zone/{zoneName}/export"); route == nil || route.Operation("GET") == nil || route.Operation("DELETE") != nil {
+		t.Fatalf("unexpected route %+v", route)
+	}
+	if route, operation := api.Operation("POST", "/domain/zone/example.com"); route == nil || operation != nil {
+		t.Fatal("expected a route without POST operation")
+	}
+	if route := api.Route("/domain/zone//record"); route != nil {
+		t.Fatalf("expected no route for an empty parameter, got %+v", route)
+	}
+
+	zone := api.Model("domain.zone.Zone")
+	if zone == nil || zone.IsEnum() || zone.Properties["nameServers"].Type != "string[]" || !zone.Properties["name"].ReadOnly {
+		t.Fatalf("unexpected model %+v", zone)
+	}
+	enum := api.Model("zone.NamedResolutionFieldTypeEnum")
+	if enum == nil || !enum.IsEnum() || len(enum.Enum) != 5 {
+		t.Fatalf("unexpected model %+v", enum)
+	}
+	if generic := api.Model("complexType.UnitAndValue<double>"); generic == nil || len(generic.Generics) != 1 {
+		t.Fatalf("unexpected model %+v", generic)
+	}
+}
+
+func TestClient(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /", http.StatusOK, json.RawMessage(`{"apiVersion":"1.0","basePath":"https://eu.api.ovh.com/1.0","apis":[{"path":"/domain","description":"Operations about the DOMAIN service","schema":"/domain.{format}"}]}`))
+	server.Handle("GET /domain.json", http.StatusOK, json.RawMessage(domainSchema))
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	index, err := client.Index(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(index.APIs) != 1 || index.APIs[0].Name() != "domain" {
+		t.Fatalf("unexpected index %+v", index)
+	}
+
+	api, err := client.API(ctx, index.APIs[0].Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.ResourcePath != "/domain" || len(api.Routes) != 3 {
+		t.Fatalf("unexpected API %+v", api)
+	}
+	if server.LastRequest().Signed {
+		t.Fatal("expected descriptions to be fetched without authentication")
+	}
+}
@@ -0,0 +1,304 @@
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// ValidationError is returned by a Validator for a call which doesn't match
+// the API description.
+type ValidationError struct {
+	Method string
+	Path   string
+	// Parameter at fault, if any.
+	Field   string
+	Message string
+}
+
+func (err *ValidationError) Error() string {
+	if err.Field == "" {
+		return fmt.Sprintf("Invalid request %s %s: %s", err.Method, err.Path, err.Message)
+	}
+	return fmt.Sprintf("Invalid request %s %s: field '%s' %s", err.Method, err.Path, err.Field, err.Message)
+}
+
+// Validator checks calls against API descriptions before they are sent,
+// instead of letting the API reject them with an opaque error:
+//
+//	validator, err := schema.New(caller).Validator(ctx, "domain", "me")
+//	caller.Validator = validator
+//
+// Calls to APIs without description are not checked.
+type Validator struct {
+	apis []*API
+}
+
+var _ govh.RequestValidator = (*Validator)(nil)
+
+// NewValidator returns a validator for the calls to apis.
+func NewValidator(apis ...*API) *Validator {
+	return &Validator{apis: apis}
+}
+
+// Validator fetches the descriptions of the given APIs, and returns a
+// validator for the calls to them.
+func (client *Client) Validator(ctx context.Context, names ...string) (*Validator, error) {
+	apis := make([]*API, len(names))
+	for i, name := range names {
+		api, err := client.API(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		apis[i] = api
+	}
+	return NewValidator(apis...), nil
+}
+
+// ValidateRequest implements govh.RequestValidator. It checks that the route
+// supports the method, and that the parameters are given with the expected
+// types and enum values.
+func (validator *Validator) ValidateRequest(method, path string, query url.Values, body []byte) error {
+	api := validator.api(path)
+	if api == nil {
+		return nil
+	}
+
+	fail := func(field, format string, args ...interface{}) error {
+		return &ValidationError{Method: method, Path: path, Field: field, Message: fmt.Sprintf(format, args...)}
+	}
+
+	route, operation := api.Operation(method, path)
+	if route == nil {
+		return fail("", "unknown route")
+	}
+	if operation == nil {
+		var methods []string
+		for _, operation := range route.Operations {
+			methods = append(methods, operation.HTTPMethod)
+		}
+		return fail("", "method not allowed, %s supports %s", route.Path, strings.Join(methods, ", "))
+	}
+
+	pathValues := map[string]string{}
+	templateSegments := strings.Split(route.Path, "/")
+	for i, segment := range strings.Split(path, "/") {
+		if isParameter(templateSegments[i]) {
+			value, err := url.PathUnescape(segment)
+			if err != nil {
+				value = segment
+			}
+			pathValues[templateSegments[i][1:len(templateSegments[i])-1]] = value
+		}
+	}
+
+	var fields map[string]json.RawMessage
+	var unnamedBody *Parameter
+	for _, param := range operation.Parameters {
+		if param.ParamType == "body" && param.Name == "" {
+			unnamedBody = param
+		}
+	}
+	if len(bytes.TrimSpace(body)) > 0 && unnamedBody == nil {
+		if err := json.Unmarshal(body, &fields); err != nil {
+			return fail("", "body must be a JSON object")
+		}
+	}
+
+	for _, param := range operation.Parameters {
+		switch param.ParamType {
+		case "path":
+			if value, ok := pathValues[param.Name]; ok {
+				if msg := validator.checkString(api, param.FullType, value); msg != "" {
+					return fail(param.Name, "%s", msg)
+				}
+			}
+
+		case "query":
+			values, ok := query[param.Name]
+			if !ok {
+				if param.Required {
+					return fail(param.Name, "is required")
+				}
+				continue
+			}
+			for _, value := range values {
+				if msg := validator.checkString(api, param.FullType, value); msg != "" {
+					return fail(param.Name, "%s", msg)
+				}
+			}
+
+		case "body":
+			if param.Name == "" {
+				if param.Required && len(bytes.TrimSpace(body)) == 0 {
+					return fail("", "body is required")
+				}
+				if len(bytes.TrimSpace(body)) > 0 {
+					if field, msg := validator.checkJSON(api, param.FullType, body, nil); msg != "" {
+						return fail(field, "%s", msg)
+					}
+				}
+				continue
+			}
+
+			value, ok := fields[param.Name]
+			if !ok || bytes.Equal(value, []byte("null")) {
+				if param.Required {
+					return fail(param.Name, "is required")
+				}
+				continue
+			}
+			if field, msg := validator.checkJSON(api, param.FullType, value, nil); msg != "" {
+				return fail(joinField(param.Name, field), "%s", msg)
+			}
+		}
+	}
+
+	return nil
+}
+
+// api returns the description of the API of path, or nil.
+func (validator *Validator) api(path string) *API {
+	var best *API
+	for _, api := range validator.apis {
+		prefix := strings.TrimSuffix(api.ResourcePath, "/")
+		if path != prefix && !strings.HasPrefix(path, prefix+"/") {
+			continue
+		}
+		if best == nil || len(api.ResourcePath) > len(best.ResourcePath) {
+			best = api
+		}
+	}
+	return best
+}
+
+// checkString checks a path or query parameter value against its type, and
+// returns a message describing the mismatch, if any.
+func (validator *Validator) checkString(api *API, fullType, value string) string {
+	fullType = strings.TrimSuffix(fullType, "[]")
+	switch fullType {
+	case "long", "int":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return "must be an integer"
+		}
+	case "double":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "must be a number"
+		}
+	case "boolean":
+		if value != "true" && value != "false" {
+			return "must be true or false"
+		}
+	}
+	if model := api.Model(fullType); model != nil && model.IsEnum() {
+		return checkEnum(model, value)
+	}
+	return ""
+}
+
+// checkJSON checks a JSON value against its type, and returns the path of the
+// field at fault within the value, if any, and a message describing the
+// mismatch. Generics are the type parameters in scope.
+func (validator *Validator) checkJSON(api *API, fullType string, data json.RawMessage, generics []string) (string, string) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return "", ""
+	}
+	for _, generic := range generics {
+		if fullType == generic {
+			return "", ""
+		}
+	}
+
+	if strings.HasSuffix(fullType, "[]") {
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return "", "must be an array"
+		}
+		for i, item := range items {
+			if field, msg := validator.checkJSON(api, strings.TrimSuffix(fullType, "[]"), item, generics); msg != "" {
+				return joinField(strconv.Itoa(i), field), msg
+			}
+		}
+		return "", ""
+	}
+
+	switch fullType {
+	case "long", "int":
+		var n int64
+		if json.Unmarshal(data, &n) != nil {
+			return "", "must be an integer"
+		}
+		return "", ""
+	case "double":
+		var f float64
+		if json.Unmarshal(data, &f) != nil {
+			return "", "must be a number"
+		}
+		return "", ""
+	case "boolean":
+		var b bool
+		if json.Unmarshal(data, &b) != nil {
+			return "", "must be true or false"
+		}
+		return "", ""
+	}
+
+	model := api.Model(fullType)
+	if model == nil {
+		return "", ""
+	}
+	if model.IsEnum() {
+		var s string
+		if json.Unmarshal(data, &s) != nil {
+			return "", "must be a string"
+		}
+		return "", checkEnum(model, s)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", "must be an object"
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		prop, ok := model.Properties[name]
+		if !ok || prop.ReadOnly {
+			continue
+		}
+		propType := prop.FullType
+		if propType == "" {
+			propType = prop.Type
+		}
+		if field, msg := validator.checkJSON(api, propType, fields[name], model.Generics); msg != "" {
+			return joinField(name, field), msg
+		}
+	}
+	return "", ""
+}
+
+func checkEnum(model *Model, value string) string {
+	for _, allowed := range model.Enum {
+		if value == allowed {
+			return ""
+		}
+	}
+	return "must be one of " + strings.Join(model.Enum, ", ")
+}
+
+func joinField(parent, field string) string {
+	if field == "" {
+		return parent
+	}
+	return parent + "." + field
+}
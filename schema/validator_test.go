@@ -0,0 +1,143 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestValidator(t *testing.T) {
+	api, err := Parse(strings.NewReader(domainSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator := NewValidator(api)
+
+	for _, test := range []struct {
+		method, path, body string
+		field, message     string
+	}{
+		{"GET", "/domain/zone/example.com", "", "", ""},
+		{"GET", "/me", "", "", ""},
+		{"POST", "/domain/zone/example.com/record", `{"fieldType":"A","target":"192.0.2.1"}`, "", ""},
+		{"POST", "/domain/zone/example.com/record", `{"fieldType":"SPF","target":"v=spf1"}`, "fieldType", "field 'fieldType' must be one of A, AAAA, CNAME, MX, TXT"},
+		{"POST", "/domain/zone/example.com/record", `{"fieldType":"A"}`, "target", "field 'target' is required"},
+		{"POST", "/domain/zone/example.com/record", `{"fieldType":"A","target":null}`, "target", "field 'target' is required"},
+		{"POST", "/domain/zone/example.com/record", `["A"]`, "", "body must be a JSON object"},
+		{"POST", "/domain/zone/example.com", "", "", "method not allowed, /domain/zone/{zoneName} supports GET"},
+		{"GET", "/domain/unknown/route/here", "", "", "unknown route"},
+	} {
+		err := validator.ValidateRequest(test.method, test.path, nil, []byte(test.body))
+		if test.message == "" {
+			if err != nil {
+				t.Errorf("%s %s %s: %s", test.method, test.path, test.body, err)
+			}
+			continue
+		}
+
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Field != test.field || !strings.HasSuffix(err.Error(), test.message) {
+			t.Errorf("%s %s %s: expected error %q on field %q, got %v", test.method, test.path, test.body, test.message, test.field, err)
+		}
+	}
+}
+
+func TestValidatorTypes(t *testing.T) {
+	api := &API{
+		ResourcePath: "/dedicated/server",
+		Routes: []*Route{{
+			Path: "/dedicated/server/{serviceName}/task/{taskId}",
+			Operations: []*Operation{{HTTPMethod: "GET", Parameters: []*Parameter{
+				{Name: "serviceName", ParamType: "path", FullType: "string", Required: true},
+				{Name: "taskId", ParamType: "path", FullType: "long", Required: true},
+			}}},
+		}, {
+			Path: "/dedicated/server/{serviceName}/task",
+			Operations: []*Operation{{HTTPMethod: "GET", Parameters: []*Parameter{
+				{Name: "function", ParamType: "query", FullType: "dedicated.TaskFunctionEnum"},
+				{Name: "status", ParamType: "query", FullType: "boolean", Required: true},
+			}}},
+		}, {
+			Path: "/dedicated/server/{serviceName}",
+			Operations: []*Operation{{HTTPMethod: "PUT", Parameters: []*Parameter{
+				{Name: "", ParamType: "body", FullType: "dedicated.Server", Required: true},
+			}}},
+		}},
+		Models: map[string]*Model{
+			"dedicated.TaskFunctionEnum": {Enum: []string{"hardReboot", "reinstallServer"}},
+			"dedicated.Server": {Properties: map[string]*Property{
+				"monitoring": {FullType: "boolean"},
+				"state":      {FullType: "string", ReadOnly: true},
+				"bootIds":    {FullType: "long[]"},
+			}},
+		},
+	}
+	validator := NewValidator(api)
+
+	for _, test := range []struct {
+		path  string
+		query url.Values
+		body  string
+		field string
+	}{
+		{"/dedicated/server/ns1/task/42", nil, "", ""},
+		{"/dedicated/server/ns1/task/abc", nil, "", "taskId"},
+		{"/dedicated/server/ns1/task", url.Values{"status": {"true"}, "function": {"hardReboot"}}, "", ""},
+		{"/dedicated/server/ns1/task", url.Values{"status": {"yes"}}, "", "status"},
+		{"/dedicated/server/ns1/task", url.Values{"function": {"hardReboot"}}, "", "status"},
+		{"/dedicated/server/ns1/task", url.Values{"status": {"true"}, "function": {"reboot"}}, "", "function"},
+		{"/dedicated/server/ns1", nil, `{"monitoring":true,"state":42,"bootIds":[1,2]}`, ""},
+		{"/dedicated/server/ns1", nil, `{"monitoring":"yes"}`, "monitoring"},
+		{"/dedicated/server/ns1", nil, `{"bootIds":[1,"two"]}`, "bootIds.1"},
+	} {
+		method := "GET"
+		if test.body != "" {
+			method = "PUT"
+		}
+		err := validator.ValidateRequest(method, test.path, test.query, []byte(test.body))
+		if test.field == "" {
+			if err != nil {
+				t.Errorf("%s %v %s: %s", test.path, test.query, test.body, err)
+			}
+			continue
+		}
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Field != test.field {
+			t.Errorf("%s %v %s: expected an error on field %q, got %v", test.path, test.query, test.body, test.field, err)
+		}
+	}
+}
+
+func TestValidatorWithCaller(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain.json", http.StatusOK, json.RawMessage(domainSchema))
+	server.Handle("POST /domain/zone/{zoneName}/record", http.StatusOK, map[string]interface{}{"id": 1})
+
+	caller := server.Caller()
+	validator, err := New(caller).Validator(context.Background(), "domain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	caller.Validator = validator
+
+	count := len(server.Requests())
+	err = caller.Post("/domain/zone/example.com/record", map[string]string{"fieldType": "SPF", "target": "v=spf1"}, nil)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if len(server.Requests()) != count {
+		t.Fatal("expected the invalid call not to be sent")
+	}
+
+	if err := caller.Post("/domain/zone/example.com/record", map[string]string{"fieldType": "A", "target": "192.0.2.1"}, nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// Renewal modes of a service.
+const (
+	RenewAutomatic = "automatic"
+	RenewManual    = "manual"
+)
+
+// Service is a service of the account, such as a domain or a dedicated
+// server.
+type Service struct {
+	ServiceID       int64           `json:"serviceId"`
+	ParentServiceID int64           `json:"parentServiceId"`
+	Billing         ServiceBilling  `json:"billing"`
+	Resource        ServiceResource `json:"resource"`
+	Route           ServiceRoute    `json:"route"`
+}
+
+// ServiceBilling describes how a service is billed.
+type ServiceBilling struct {
+	Plan            ServicePlan      `json:"plan"`
+	ExpirationDate  govh.DateTime    `json:"expirationDate"`
+	NextBillingDate govh.DateTime    `json:"nextBillingDate"`
+	Lifecycle       ServiceLifecycle `json:"lifecycle"`
+	Renew           ServiceRenew     `json:"renew"`
+}
+
+// ServicePlan is the commercial plan of a service.
+type ServicePlan struct {
+	Code        string `json:"code"`
+	InvoiceName string `json:"invoiceName"`
+}
+
+// ServiceLifecycle is the state of a service.
+type ServiceLifecycle struct {
+	Current struct {
+		// State of the service: active, error, rupture, terminated or
+		// toRenew.
+		State           string        `json:"state"`
+		CreationDate    govh.DateTime `json:"creationDate"`
+		TerminationDate govh.DateTime `json:"terminationDate"`
+		PendingActions  []string      `json:"pendingActions"`
+	} `json:"current"`
+	Capacities struct {
+		Actions []string `json:"actions"`
+	} `json:"capacities"`
+}
+
+// ServiceRenew is the renewal of a service.
+type ServiceRenew struct {
+	Current struct {
+		// Mode is RenewAutomatic or RenewManual, or empty for services which
+		// are not renewed.
+		Mode     string        `json:"mode"`
+		NextDate govh.DateTime `json:"nextDate"`
+		// Period in ISO 8601 format, such as P1M.
+		Period string `json:"period"`
+	} `json:"current"`
+	Capacities struct {
+		Mode []string `json:"mode"`
+	} `json:"capacities"`
+}
+
+// ServiceResource is the resource delivered by a service.
+type ServiceResource struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	State       string `json:"state"`
+	Product     struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"product"`
+}
+
+// ServiceRoute is the API route of the resource of a service.
+type ServiceRoute struct {
+	Path string `json:"path"`
+	URL  string `json:"url"`
+}
+
+// Engagement is the commitment of a service.
+type Engagement struct {
+	CurrentPeriod struct {
+		StartDate govh.DateTime `json:"startDate"`
+		EndDate   govh.DateTime `json:"endDate"`
+	} `json:"currentPeriod"`
+	// Action at the end of the engagement: REACTIVATE_ENGAGEMENT,
+	// STOP_ENGAGEMENT_FALLBACK_DEFAULT_PRICE or
+	// STOP_ENGAGEMENT_KEEP_PRICE.
+	EndAction string `json:"endAction"`
+	EndRule   struct {
+		Strategy           string   `json:"strategy"`
+		PossibleStrategies []string `json:"possibleStrategies"`
+	} `json:"endRule"`
+}
+
+// ServiceFilter filters the services returned by Services.
+type ServiceFilter struct {
+	ResourceName string `url:"resourceName,omitempty"`
+	// Comma separated API routes of the resources, such as
+	// "/domain,/dedicated/server".
+	Routes string `url:"routes,omitempty"`
+}
+
+// Services returns the identifiers of the services of the account. A nil
+// filter returns all of them.
+func (client *Client) Services(ctx context.Context, filter *ServiceFilter) ([]int64, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, "/services", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Service returns the details of a service.
+func (client *Client) Service(ctx context.Context, serviceID int64) (*Service, error) {
+	service := &Service{}
+	if err := client.api.GetWithContext(ctx, servicePath(serviceID), service); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// SetDisplayName sets the name of a service shown in the control panel.
+func (client *Client) SetDisplayName(ctx context.Context, serviceID int64, displayName string) error {
+	body := map[string]interface{}{"displayName": displayName}
+	return client.api.PutWithContext(ctx, servicePath(serviceID), body, nil)
+}
+
+// Engagement returns the commitment of a service.
+func (client *Client) Engagement(ctx context.Context, serviceID int64) (*Engagement, error) {
+	engagement := &Engagement{}
+	if err := client.api.GetWithContext(ctx, servicePath(serviceID)+"/billing/engagement", engagement); err != nil {
+		return nil, err
+	}
+	return engagement, nil
+}
+
+// SetEngagementEndStrategy sets what happens at the end of the commitment of
+// a service, one of its possible strategies.
+func (client *Client) SetEngagementEndStrategy(ctx context.Context, serviceID int64, strategy string) error {
+	body := map[string]interface{}{"strategy": strategy}
+	return client.api.PutWithContext(ctx, servicePath(serviceID)+"/billing/engagement/endRule", body, nil)
+}
+
+func servicePath(serviceID int64) string {
+	return fmt.Sprintf("/services/%d", serviceID)
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestServices(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /services", 200, []int64{42})
+	server.Handle("GET /services/42", 200, json.RawMessage(`{
+		"serviceId": 42,
+		"billing": {
+			"plan": {"code": "domain-com", "invoiceName": "Domain .com"},
+			"expirationDate": "2025-03-01T00:00:00+01:00",
+			"lifecycle": {"current": {"state": "active", "pendingActions": []}, "capacities": {"actions": ["terminate"]}},
+			"renew": {"current": {"mode": "automatic", "nextDate": "2025-03-01T00:00:00+01:00", "period": "P1Y"}, "capacities": {"mode": ["automatic", "manual"]}}
+		},
+		"resource": {"name": "example.com", "displayName": "example.com", "state": "ok", "product": {"name": "domain"}},
+		"route": {"path": "/domain/{serviceName}", "url": "/domain/example.com"}
+	}`))
+	server.Handle("PUT /services/42", 200, nil)
+	server.Handle("GET /services/42/billing/engagement", 200, json.RawMessage(`{
+		"currentPeriod": {"startDate": "2024-03-01", "endDate": "2025-03-01"},
+		"endAction": "REACTIVATE_ENGAGEMENT",
+		"endRule": {"strategy": "REACTIVATE_ENGAGEMENT", "possibleStrategies": ["REACTIVATE_ENGAGEMENT", "STOP_ENGAGEMENT_KEEP_PRICE"]}
+	}`))
+	server.Handle("PUT /services/42/billing/engagement/endRule", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	ids, err := client.Services(ctx, &ServiceFilter{Routes: "/domain"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 || server.LastRequest().Query.Get("routes") != "/domain" {
+		t.Fatalf("unexpected services %v", ids)
+	}
+
+	service, err := client.Service(ctx, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if service.Resource.Name != "example.com" || service.Billing.Renew.Current.Mode != RenewAutomatic || service.Billing.ExpirationDate.Year() != 2025 || service.Route.URL != "/domain/example.com" {
+		t.Fatalf("unexpected service %+v", service)
+	}
+
+	if err := client.SetDisplayName(ctx, 42, "Main domain"); err != nil {
+		t.Fatal(err)
+	}
+
+	engagement, err := client.Engagement(ctx, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(engagement.EndRule.PossibleStrategies) != 2 || engagement.CurrentPeriod.EndDate.Year() != 2025 {
+		t.Fatalf("unexpected engagement %+v", engagement)
+	}
+	if err := client.SetEngagementEndStrategy(ctx, 42, "STOP_ENGAGEMENT_KEEP_PRICE"); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"strategy":"STOP_ENGAGEMENT_KEEP_PRICE"}` {
+		t.Fatalf("unexpected end rule %s", body)
+	}
+}
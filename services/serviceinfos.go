@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	govh "github.com/garbage-collector/ovh-go"
+)
+
+// ServiceInfos is the subscription of a service, as returned by the
+// serviceInfos route of its product.
+type ServiceInfos struct {
+	ServiceID  int64     `json:"serviceId"`
+	Domain     string    `json:"domain"`
+	Status     string    `json:"status"`
+	Creation   govh.Date `json:"creation"`
+	Expiration govh.Date `json:"expiration"`
+	// End of the commitment of the service, if any.
+	EngagedUpTo           govh.Date `json:"engagedUpTo"`
+	ContactAdmin          string    `json:"contactAdmin"`
+	ContactBilling        string    `json:"contactBilling"`
+	ContactTech           string    `json:"contactTech"`
+	RenewalType           string    `json:"renewalType"`
+	Renew                 *Renew    `json:"renew"`
+	PossibleRenewPeriod   []int64   `json:"possibleRenewPeriod"`
+	CanDeleteAtExpiration bool      `json:"canDeleteAtExpiration"`
+}
+
+// Renew is the renewal of a service.
+type Renew struct {
+	Automatic bool `json:"automatic"`
+	// Whether the service is deleted when it expires, instead of renewed.
+	DeleteAtExpiration bool `json:"deleteAtExpiration"`
+	Forced             bool `json:"forced"`
+	ManualPayment      bool `json:"manualPayment"`
+	// Renewal period in months, one of ServiceInfos.PossibleRenewPeriod.
+	Period int64 `json:"period,omitempty"`
+}
+
+// ServiceInfos returns the subscription of the service with the given API
+// path, such as /domain/example.com or /dedicated/server/ns1234.ovh.net.
+func (client *Client) ServiceInfos(ctx context.Context, path string) (*ServiceInfos, error) {
+	infos := &ServiceInfos{}
+	if err := client.api.GetWithContext(ctx, productPath(path, "serviceInfos"), infos); err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
+
+// UpdateRenew sets the renewal of the service with the given API path.
+func (client *Client) UpdateRenew(ctx context.Context, path string, renew *Renew) error {
+	body := map[string]interface{}{"renew": renew}
+	return client.api.PutWithContext(ctx, productPath(path, "serviceInfos"), body, nil)
+}
+
+// productPath returns the path of a route of the service with the given API
+// path.
+func productPath(path, route string) string {
+	return "/" + strings.Trim(path, "/") + "/" + route
+}
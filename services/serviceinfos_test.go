@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestServiceInfos(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("GET /domain/example.com/serviceInfos", 200, map[string]interface{}{
+		"serviceId":           42,
+		"domain":              "example.com",
+		"status":              "ok",
+		"creation":            "2020-03-01",
+		"expiration":          "2025-03-01",
+		"renew":               map[string]interface{}{"automatic": true, "deleteAtExpiration": false, "forced": false, "period": 12},
+		"possibleRenewPeriod": []int64{12, 24},
+	})
+	server.Handle("PUT /domain/example.com/serviceInfos", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	infos, err := client.ServiceInfos(ctx, "/domain/example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if infos.Expiration.String() != "2025-03-01" || !infos.Renew.Automatic || infos.Renew.Period != 12 {
+		t.Fatalf("unexpected service infos %+v", infos)
+	}
+
+	if err := client.UpdateRenew(ctx, "domain/example.com", &Renew{Automatic: false, Period: 24}); err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"renew":{"automatic":false,"deleteAtExpiration":false,"forced":false,"manualPayment":false,"period":24}}`
+	if body := string(server.LastRequest().Body); body != expected {
+		t.Fatalf("unexpected renew %s", body)
+	}
+}
@@ -0,0 +1,17 @@
+// Package services wraps the /services routes of the OVH API, which list the
+// services of the account whatever their product, and the serviceInfos and
+// terminate routes of each product, which manage their renewal and
+// termination.
+package services
+
+import govh "github.com/garbage-collector/ovh-go"
+
+// Client calls the /services routes, and the service routes of products.
+type Client struct {
+	api govh.Client
+}
+
+// New returns a Client calling the API with client, usually a *govh.Caller.
+func New(client govh.Client) *Client {
+	return &Client{api: client}
+}
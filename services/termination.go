@@ -0,0 +1,51 @@
+package services
+
+import "context"
+
+// Termination reasons, as given to ConfirmTermination.
+const (
+	ReasonFeaturesDontSuit             = "FEATURES_DONT_SUIT_ME"
+	ReasonLackOfPerformances           = "LACK_OF_PERFORMANCES"
+	ReasonMigratedToAnotherOVHProduct  = "MIGRATED_TO_ANOTHER_OVH_PRODUCT"
+	ReasonMigratedToCompetitor         = "MIGRATED_TO_COMPETITOR"
+	ReasonNotNeededAnymore             = "NOT_NEEDED_ANYMORE"
+	ReasonNotReliable                  = "NOT_RELIABLE"
+	ReasonNoAnswer                     = "NO_ANSWER"
+	ReasonOther                        = "OTHER"
+	ReasonTooExpensive                 = "TOO_EXPENSIVE"
+	ReasonTooHardToUse                 = "TOO_HARD_TO_USE"
+	ReasonUnsatisfiedByCustomerSupport = "UNSATIFIED_BY_CUSTOMER_SUPPORT"
+)
+
+// TerminationConfirmation confirms the termination of a service.
+type TerminationConfirmation struct {
+	// Token received by email after Terminate.
+	Token      string `json:"token"`
+	Reason     string `json:"reason,omitempty"`
+	Commentary string `json:"commentary,omitempty"`
+	// What the service will be replaced by, in free text.
+	FutureUse string `json:"futureUse,omitempty"`
+}
+
+// Terminate asks for the termination of the service with the given API path,
+// such as /dedicated/server/ns1234.ovh.net. A token confirming the
+// termination is sent by email to the administrator of the service, to be
+// given to ConfirmTermination. The message of the API is returned.
+func (client *Client) Terminate(ctx context.Context, path string) (string, error) {
+	var message string
+	if err := client.api.PostWithContext(ctx, productPath(path, "terminate"), nil, &message); err != nil {
+		return "", err
+	}
+	return message, nil
+}
+
+// ConfirmTermination confirms the termination of the service with the given
+// API path, which is terminated at its expiration. The message of the API is
+// returned.
+func (client *Client) ConfirmTermination(ctx context.Context, path string, confirmation *TerminationConfirmation) (string, error) {
+	var message string
+	if err := client.api.PostWithContext(ctx, productPath(path, "confirmTermination"), confirmation, &message); err != nil {
+		return "", err
+	}
+	return message, nil
+}
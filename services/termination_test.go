@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garbage-collector/ovh-go/govhtest"
+)
+
+func TestTermination(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("POST /dedicated/server/ns1234.ovh.net/terminate", 200, "An email has been sent to the administrator")
+	server.Handle("POST /dedicated/server/ns1234.ovh.net/confirmTermination", 200, "Termination confirmed")
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	message, err := client.Terminate(ctx, "/dedicated/server/ns1234.ovh.net")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message != "An email has been sent to the administrator" {
+		t.Fatalf("unexpected message %q", message)
+	}
+
+	confirmation := &TerminationConfirmation{Token: "tok3n", Reason: ReasonNotNeededAnymore}
+	if _, err := client.ConfirmTermination(ctx, "/dedicated/server/ns1234.ovh.net", confirmation); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"token":"tok3n","reason":"NOT_NEEDED_ANYMORE"}` {
+		t.Fatalf("unexpected confirmation %s", body)
+	}
+}
@@ -0,0 +1,55 @@
+package govh
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Sign computes the signature of a request, as sent in the X-Ovh-Signature
+// header: "$1$" followed by the hex encoded SHA1 of the application secret,
+// the consumer key, the method, the complete URL, the body and the
+// timestamp, joined by "+".
+func Sign(applicationSecret, consumerKey, method, url, body string, timestamp int64) string {
+	h := sha1.New()
+	sig := strings.Join([]string{
+		applicationSecret,
+		consumerKey,
+		method,
+		url,
+		body,
+		strconv.FormatInt(timestamp, 10),
+	}, "+")
+	io.WriteString(h, sig)
+	return "$1$" + hex.EncodeToString(h.Sum(nil))
+}
+
+// SignRequest adds the authentication headers to a request built outside of
+// the caller, e.g. to be sent later through another transport. The request
+// URL must be complete, and its body is read and restored.
+func (caller *Caller) SignRequest(request *http.Request) error {
+	var body []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return err
+		}
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+		request.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	return caller.authProvider(&callOptions{}).SignRequest(request, body, caller.now())
+}
+
+func (caller *Caller) getSignature(consumerKey, method, url, body string, timestamp int64) string {
+	return Sign(caller.ApplicationSecret, consumerKey, method, url, body, timestamp)
+}
@@ -0,0 +1,36 @@
+package govh
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSignRequest(t *testing.T) {
+	c := &Caller{ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "ck"}
+
+	body := `{"fieldType":"A"}`
+	request, err := http.NewRequest("POST", "https://eu.api.ovh.com/1.0/domain/zone/example.com/record", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SignRequest(request); err != nil {
+		t.Fatal(err)
+	}
+
+	timestamp, err := strconv.ParseInt(request.Header.Get("X-Ovh-Timestamp"), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Sign("as", "ck", "POST", "https://eu.api.ovh.com/1.0/domain/zone/example.com/record", body, timestamp)
+	if request.Header.Get("X-Ovh-Signature") != expected {
+		t.Fatalf("unexpected signature %q", request.Header.Get("X-Ovh-Signature"))
+	}
+
+	restored, _ := ioutil.ReadAll(request.Body)
+	if string(restored) != body {
+		t.Fatalf("body was not restored: %q", restored)
+	}
+}
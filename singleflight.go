@@ -0,0 +1,69 @@
+package govh
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// flightGroup coalesces identical concurrent calls into a single request.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight is a call in progress, whose outcome is shared by all the callers
+// waiting for it.
+type flight struct {
+	wg     sync.WaitGroup
+	result *http.Response
+	body   []byte
+	err    error
+}
+
+// do performs fn, unless a call with the same key is already in flight: then
+// it waits for that call and returns its outcome.
+func (group *flightGroup) do(key string, fn func() (*http.Response, []byte, error)) (*http.Response, []byte, error) {
+	group.mu.Lock()
+	if group.flights == nil {
+		group.flights = make(map[string]*flight)
+	}
+	if f, ok := group.flights[key]; ok {
+		group.mu.Unlock()
+		f.wg.Wait()
+		return f.result, f.body, f.err
+	}
+
+	f := &flight{}
+	f.wg.Add(1)
+	group.flights[key] = f
+	group.mu.Unlock()
+
+	f.result, f.body, f.err = fn()
+	f.wg.Done()
+
+	group.mu.Lock()
+	delete(group.flights, key)
+	group.mu.Unlock()
+
+	return f.result, f.body, f.err
+}
+
+// coalesceKey returns the key of a GET call to completeURL: calls are only
+// coalesced when they are sent with the same credentials, authentication
+// mode and headers, and would thus get the same response.
+func (caller *Caller) coalesceKey(options *callOptions, completeURL string) string {
+	parts := []string{caller.authKey(options)}
+
+	names := make([]string, 0, len(options.header))
+	for name := range options.header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		parts = append(parts, name+": "+strings.Join(options.header[name], ", "))
+	}
+
+	return strings.Join(append(parts, completeURL), "\n")
+}
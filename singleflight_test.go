@@ -0,0 +1,98 @@
+package govh
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCoalesceRequests(t *testing.T) {
+	var calls int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+		}
+		<-release
+		w.Write([]byte(`{"name":"ovh"}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, CoalesceRequests: true}
+
+	var wg sync.WaitGroup
+	call := func() {
+		defer wg.Done()
+		var me struct{ Name string }
+		if err := c.CallAPI("/me", "GET", nil, &me); err != nil || me.Name != "ovh" {
+			t.Errorf("unexpected result %+v (%v)", me, err)
+		}
+	}
+
+	wg.Add(1)
+	go call()
+	<-started
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go call()
+	}
+	// Let the other calls join the one in flight.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("expected a single request, got %d", calls)
+	}
+}
+
+func TestCoalesceRequestsHeaders(t *testing.T) {
+	var calls int32
+	arrived := make(chan struct{}, 3)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		arrived <- struct{}{}
+		<-release
+		w.Write([]byte(`{"name":"` + r.Header.Get("X-Pagination-Size") + `"}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, CoalesceRequests: true}
+
+	var wg sync.WaitGroup
+	call := func(size string, opts ...CallOption) {
+		defer wg.Done()
+		var result struct{ Name string }
+		if err := c.CallAPIWithContext(context.Background(), "/v2/items", "GET", nil, &result, opts...); err != nil || result.Name != size {
+			t.Errorf("unexpected result %+v for %q (%v)", result, size, err)
+		}
+	}
+
+	wg.Add(3)
+	go call("10", WithHeader("X-Pagination-Size", "10"))
+	go call("20", WithHeader("X-Pagination-Size", "20"))
+	go call("", Unauthenticated())
+	// Coalesced calls never reach the server: stop waiting for them.
+	timeout := time.After(time.Second)
+wait:
+	for i := 0; i < 3; i++ {
+		select {
+		case <-arrived:
+		case <-timeout:
+			break wait
+		}
+	}
+	close(release)
+	wg.Wait()
+
+	if calls != 3 {
+		t.Fatalf("expected a request per header, got %d", calls)
+	}
+}
@@ -0,0 +1,108 @@
+package govh
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// latencySamples is the number of recent call latencies kept to compute
+// percentiles.
+const latencySamples = 1024
+
+// Stats is a snapshot of the calls made by a Caller.
+type Stats struct {
+	// Calls made, whatever their outcome.
+	Calls int64
+	// Calls which failed without a response from the API: network errors,
+	// cancellations, open circuit breaker, read-only caller...
+	TransportErrors int64
+	// Calls answered with a 4xx status code.
+	ClientErrors int64
+	// Calls answered with a 5xx status code.
+	ServerErrors int64
+	// Retries performed by the retry policy.
+	Retries int64
+	// Requests delayed by the rate limiter.
+	RateLimiterWaits int64
+	// Requests answered with a 429 status code, including retried ones.
+	RateLimited int64
+	// Latency percentiles of the most recent calls, including retries.
+	LatencyP50 time.Duration
+	LatencyP95 time.Duration
+}
+
+// callStats accumulates the statistics of a Caller.
+type callStats struct {
+	mu        sync.Mutex
+	stats     Stats
+	latencies []time.Duration
+	next      int
+}
+
+// Stats returns a snapshot of the calls made by the caller since its
+// creation. Copies made by Clone start with empty statistics.
+func (caller *Caller) Stats() Stats {
+	caller.stats.mu.Lock()
+	defer caller.stats.mu.Unlock()
+
+	stats := caller.stats.stats
+	if n := len(caller.stats.latencies); n > 0 {
+		sorted := append([]time.Duration(nil), caller.stats.latencies...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		stats.LatencyP50 = sorted[(n-1)*50/100]
+		stats.LatencyP95 = sorted[(n-1)*95/100]
+	}
+	return stats
+}
+
+// recordCall records the outcome of a call.
+func (stats *callStats) recordCall(result *http.Response, err error, duration time.Duration) {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	stats.stats.Calls++
+	switch {
+	case err != nil || result == nil:
+		stats.stats.TransportErrors++
+	case result.StatusCode >= http.StatusInternalServerError:
+		stats.stats.ServerErrors++
+	case result.StatusCode >= http.StatusBadRequest:
+		stats.stats.ClientErrors++
+	}
+	if len(stats.latencies) < latencySamples {
+		stats.latencies = append(stats.latencies, duration)
+	} else {
+		stats.latencies[stats.next] = duration
+		stats.next = (stats.next + 1) % latencySamples
+	}
+}
+
+// recordRetry records a retry of a call.
+func (stats *callStats) recordRetry() {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	stats.stats.Retries++
+}
+
+// recordResponse records a response to a request, including retried ones.
+func (stats *callStats) recordResponse(result *http.Response) {
+	if result.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	stats.stats.RateLimited++
+}
+
+// recordRateLimiterWait records a request delayed by the rate limiter.
+func (stats *callStats) recordRateLimiterWait() {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	stats.stats.RateLimiterWaits++
+}
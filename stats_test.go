@@ -0,0 +1,67 @@
+package govh
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/flaky":
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"message":"Too many requests"}`))
+				return
+			}
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+			return
+		case "/down":
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"message":"unavailable"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{
+		URL:         server.URL,
+		Retry:       &RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+		RateLimiter: NewRateLimiter(1000, 1),
+	}
+
+	response, err := c.CallAPIRaw(context.Background(), "/flaky", "GET", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Attempts != 2 || response.Duration <= 0 {
+		t.Fatalf("unexpected response %+v", response)
+	}
+	c.Get("/missing", nil)
+	c.Get("/down", nil)
+	c.ReadOnly = true
+	c.Delete("/me", nil)
+
+	stats := c.Stats()
+	if stats.Calls != 4 || stats.ClientErrors != 1 || stats.ServerErrors != 1 || stats.TransportErrors != 1 {
+		t.Fatalf("unexpected call stats %+v", stats)
+	}
+	if stats.Retries != 2 || stats.RateLimiterWaits == 0 || stats.RateLimited != 1 {
+		t.Fatalf("unexpected retry stats %+v", stats)
+	}
+	if stats.LatencyP50 <= 0 || stats.LatencyP95 < stats.LatencyP50 {
+		t.Fatalf("unexpected latencies %+v", stats)
+	}
+
+	if clone := c.Clone(); clone.Stats().Calls != 0 {
+		t.Fatal("expected a clone to start with empty stats")
+	}
+}
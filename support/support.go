@@ -0,0 +1,15 @@
+// Package support wraps the /support routes of the OVH API, which manage the
+// support tickets of the account.
+package support
+
+import govh "github.com/garbage-collector/ovh-go"
+
+// Client calls the /support routes.
+type Client struct {
+	api govh.Client
+}
+
+// New returns a Client calling the API with client, usually a *govh.Caller.
+func New(client govh.Client) *Client {
+	return &Client{api: client}
+}
@@ -0,0 +1,185 @@
+package support
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	govh "github.com/garbage-collector/ovh-go"
+	"github.com/garbage-collector/ovh-go/me"
+)
+
+// Ticket types.
+const (
+	TypeCriticalIntervention = "criticalIntervention"
+	TypeGenericRequest       = "genericRequest"
+	TypeIncident             = "incident"
+)
+
+// Ticket is a support ticket.
+type Ticket struct {
+	TicketID     int64  `json:"ticketId"`
+	TicketNumber int64  `json:"ticketNumber"`
+	AccountID    string `json:"accountId"`
+	Subject      string `json:"subject"`
+	// State of the ticket: open, closed or unknown.
+	State        string        `json:"state"`
+	Type         string        `json:"type"`
+	Category     string        `json:"category"`
+	Product      string        `json:"product"`
+	ServiceName  string        `json:"serviceName"`
+	CreationDate govh.DateTime `json:"creationDate"`
+	UpdateDate   govh.DateTime `json:"updateDate"`
+	// Author of the last message: customer or support.
+	LastMessageFrom string `json:"lastMessageFrom"`
+	CanBeClosed     bool   `json:"canBeClosed"`
+}
+
+// Message is a message of a ticket.
+type Message struct {
+	MessageID int64  `json:"messageId"`
+	TicketID  int64  `json:"ticketId"`
+	AccountID string `json:"accountId"`
+	// Author of the message: customer or support.
+	From         string        `json:"from"`
+	Body         string        `json:"body"`
+	CreationDate govh.DateTime `json:"creationDate"`
+	UpdateDate   govh.DateTime `json:"updateDate"`
+}
+
+// TicketCreation describes a ticket to open.
+type TicketCreation struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	// Type of the ticket, one of the Type constants.
+	Type        string   `json:"type"`
+	Category    string   `json:"category,omitempty"`
+	Subcategory string   `json:"subcategory,omitempty"`
+	Product     string   `json:"product,omitempty"`
+	ServiceName string   `json:"serviceName,omitempty"`
+	Impact      string   `json:"impact,omitempty"`
+	Urgency     string   `json:"urgency,omitempty"`
+	Watchers    []string `json:"watchers,omitempty"`
+	// QueryIDs of failing API calls, appended to the body to help the
+	// support find them. See QueryID.
+	QueryIDs []string `json:"-"`
+	// Documents attached to the ticket, see me.Client.UploadDocument.
+	Attachments []*me.Document `json:"-"`
+}
+
+// CreatedTicket identifies a ticket opened by CreateTicket.
+type CreatedTicket struct {
+	TicketID     int64 `json:"ticketId"`
+	TicketNumber int64 `json:"ticketNumber"`
+	MessageID    int64 `json:"messageId"`
+}
+
+// TicketFilter filters the tickets returned by Tickets.
+type TicketFilter struct {
+	Status          string    `url:"status,omitempty"`
+	Category        string    `url:"category,omitempty"`
+	Product         string    `url:"product,omitempty"`
+	ServiceName     string    `url:"serviceName,omitempty"`
+	Subject         string    `url:"subject,omitempty"`
+	Archived        *bool     `url:"archived,omitempty"`
+	MinCreationDate time.Time `url:"minCreationDate,omitempty"`
+	MaxCreationDate time.Time `url:"maxCreationDate,omitempty"`
+}
+
+// QueryID returns the identifier of the failing API call of err, to be given
+// to the support, or an empty string.
+func QueryID(err error) string {
+	var apiErr *govh.ApiOvhError
+	if errors.As(err, &apiErr) {
+		return apiErr.Tracer
+	}
+	return ""
+}
+
+// Tickets returns the identifiers of the tickets of the account. A nil
+// filter returns all of them.
+func (client *Client) Tickets(ctx context.Context, filter *TicketFilter) ([]int64, error) {
+	query, err := govh.QueryFromStruct(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	if err := client.api.GetWithContext(ctx, "/support/tickets", &ids, govh.WithQuery(query)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Ticket returns the details of a ticket.
+func (client *Client) Ticket(ctx context.Context, ticketID int64) (*Ticket, error) {
+	ticket := &Ticket{}
+	if err := client.api.GetWithContext(ctx, ticketPath(ticketID), ticket); err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// Messages returns the messages of a ticket.
+func (client *Client) Messages(ctx context.Context, ticketID int64) ([]*Message, error) {
+	var messages []*Message
+	if err := client.api.GetWithContext(ctx, ticketPath(ticketID)+"/messages", &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// CreateTicket opens a ticket.
+func (client *Client) CreateTicket(ctx context.Context, creation *TicketCreation) (*CreatedTicket, error) {
+	body := *creation
+	body.Body = withAttachments(creation.Body, creation.QueryIDs, creation.Attachments)
+
+	created := &CreatedTicket{}
+	if err := client.api.PostWithContext(ctx, "/support/tickets/create", &body, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Reply adds a message to a ticket, with documents attached.
+func (client *Client) Reply(ctx context.Context, ticketID int64, message string, attachments ...*me.Document) error {
+	body := map[string]interface{}{"body": withAttachments(message, nil, attachments)}
+	return client.api.PostWithContext(ctx, ticketPath(ticketID)+"/reply", body, nil)
+}
+
+// Close closes a ticket.
+func (client *Client) Close(ctx context.Context, ticketID int64) error {
+	return client.api.PostWithContext(ctx, ticketPath(ticketID)+"/close", nil, nil)
+}
+
+// Reopen reopens a closed ticket with a message.
+func (client *Client) Reopen(ctx context.Context, ticketID int64, message string) error {
+	body := map[string]interface{}{"body": message}
+	return client.api.PostWithContext(ctx, ticketPath(ticketID)+"/reopen", body, nil)
+}
+
+// withAttachments appends query IDs and links to documents to the body of a
+// message, as tickets have no attachments of their own.
+func withAttachments(body string, queryIDs []string, attachments []*me.Document) string {
+	var b strings.Builder
+	b.WriteString(body)
+	if len(queryIDs) > 0 {
+		b.WriteString("\n\nQueryIDs of the failing calls:\n")
+		for _, queryID := range queryIDs {
+			fmt.Fprintf(&b, "- %s\n", queryID)
+		}
+	}
+	if len(attachments) > 0 {
+		b.WriteString("\n\nAttachments:\n")
+		for _, document := range attachments {
+			fmt.Fprintf(&b, "- %s: %s\n", document.Name, document.GetURL)
+		}
+	}
+	return b.String()
+}
+
+func ticketPath(ticketID int64) string {
+	return fmt.Sprintf("/support/tickets/%d", ticketID)
+}
@@ -0,0 +1,72 @@
+package support
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	govh "github.com/garbage-collector/ovh-go"
+	"github.com/garbage-collector/ovh-go/govhtest"
+	"github.com/garbage-collector/ovh-go/me"
+)
+
+func TestTickets(t *testing.T) {
+	server := govhtest.NewServer()
+	defer server.Close()
+	server.Handle("POST /support/tickets/create", 200, map[string]interface{}{"ticketId": 7, "ticketNumber": 1234567, "messageId": 70})
+	server.Handle("GET /support/tickets/7", 200, map[string]interface{}{"ticketId": 7, "ticketNumber": 1234567, "subject": "API errors", "state": "open", "type": "incident", "canBeClosed": true})
+	server.Handle("GET /support/tickets/7/messages", 200, []map[string]interface{}{
+		{"messageId": 70, "ticketId": 7, "from": "customer", "body": "Hello", "creationDate": "2024-03-01T10:00:00+01:00"},
+		{"messageId": 71, "ticketId": 7, "from": "support", "body": "Hi", "creationDate": "2024-03-01T11:00:00+01:00"},
+	})
+	server.Handle("POST /support/tickets/7/reply", 200, nil)
+	server.Handle("POST /support/tickets/7/close", 200, nil)
+
+	client := New(server.Caller())
+	ctx := context.Background()
+
+	callErr := fmt.Errorf("Listing zones: %w", &govh.ApiOvhError{Code: 500, Message: "Internal error", Tracer: "EU.ext-1.abcd"})
+	created, err := client.CreateTicket(ctx, &TicketCreation{
+		Subject:  "API errors",
+		Body:     "Calls fail.",
+		Type:     TypeIncident,
+		QueryIDs: []string{QueryID(callErr)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body map[string]interface{}
+	json.Unmarshal(server.LastRequest().Body, &body)
+	if created.TicketID != 7 || body["body"] != "Calls fail.\n\nQueryIDs of the failing calls:\n- EU.ext-1.abcd\n" || body["type"] != "incident" {
+		t.Fatalf("unexpected ticket %+v created with %v", created, body)
+	}
+
+	ticket, err := client.Ticket(ctx, created.TicketID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticket.State != "open" || !ticket.CanBeClosed {
+		t.Fatalf("unexpected ticket %+v", ticket)
+	}
+
+	messages, err := client.Messages(ctx, created.TicketID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 2 || messages[1].From != "support" {
+		t.Fatalf("unexpected messages %+v", messages)
+	}
+
+	document := &me.Document{Name: "trace.log", GetURL: "https://example.com/trace.log"}
+	if err := client.Reply(ctx, created.TicketID, "Logs attached.", document); err != nil {
+		t.Fatal(err)
+	}
+	if body := string(server.LastRequest().Body); body != `{"body":"Logs attached.\n\nAttachments:\n- trace.log: https://example.com/trace.log\n"}` {
+		t.Fatalf("unexpected reply %s", body)
+	}
+
+	if err := client.Close(ctx, created.TicketID); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,158 @@
+package govh
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrTaskTimeout is returned by WaitForTask when the task is not done once
+// the timeout elapsed.
+var ErrTaskTimeout = errors.New("govh: timeout waiting for task")
+
+// TaskError is returned by WaitForTaskPath when a task ends with an error.
+type TaskError struct {
+	// Path of the task.
+	Path string
+	// Final status of the task, e.g. "error" or "cancelled".
+	Status string
+	// Comment of the task, explaining the failure when available.
+	Comment string
+}
+
+func (err *TaskError) Error() string {
+	if err.Comment == "" {
+		return fmt.Sprintf("Task %s ended with status %q", err.Path, err.Status)
+	}
+	return fmt.Sprintf("Task %s ended with status %q: %s", err.Path, err.Status, err.Comment)
+}
+
+// TaskOptions configures the polling of WaitForTask. The zero value polls
+// every second at first, backing off up to every 30 seconds, until ctx is
+// done.
+type TaskOptions struct {
+	// Delay between the first two polls. It grows by half after each poll.
+	Interval time.Duration
+	// Upper bound for the delay between two polls.
+	MaxInterval time.Duration
+	// Time after which ErrTaskTimeout is returned, if not zero.
+	Timeout time.Duration
+}
+
+// TaskFunc fetches the state of an asynchronous task. It returns whether the
+// task is done, along with its result; an error stops the polling.
+type TaskFunc[T any] func(ctx context.Context) (result T, done bool, err error)
+
+// WaitForTask polls fetch until the task is done, and returns its result:
+//
+//	server, err := govh.WaitForTask(ctx, func(ctx context.Context) (Server, bool, error) {
+//		var server Server
+//		err := caller.GetWithContext(ctx, "/dedicated/server/"+name, &server)
+//		return server, server.State == "ok", err
+//	}, nil)
+//
+// It returns the error of fetch, ErrTaskTimeout once options.Timeout
+// elapsed, or the error of ctx when it is done. Options may be nil.
+func WaitForTask[T any](ctx context.Context, fetch TaskFunc[T], options *TaskOptions) (T, error) {
+	var opts TaskOptions
+	if options != nil {
+		opts = *options
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = time.Second
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = 30 * time.Second
+	}
+	if opts.MaxInterval < opts.Interval {
+		opts.MaxInterval = opts.Interval
+	}
+
+	parent := ctx
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	interval := opts.Interval
+	for {
+		result, done, err := fetch(ctx)
+		if err == nil && done {
+			return result, nil
+		}
+		if err == nil {
+			err = sleepContext(ctx, interval)
+		}
+		if err != nil {
+			var zero T
+			if ctx.Err() != nil && parent.Err() == nil {
+				return zero, ErrTaskTimeout
+			}
+			return zero, err
+		}
+
+		interval += interval / 2
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+// WaitForTaskPath polls a task route of the API, such as
+// /dedicated/server/{serviceName}/task/{taskId} or
+// /domain/zone/{zoneName}/task/{id}, until its status tells it ended. The
+// last state of the task is decoded into T. A *TaskError is returned if the
+// task failed or was cancelled. See WaitForTask for options.
+func WaitForTaskPath[T any](ctx context.Context, client Client, path string, options *TaskOptions) (T, error) {
+	return WaitForTask(ctx, func(ctx context.Context) (T, bool, error) {
+		var result T
+		var raw json.RawMessage
+		if err := client.GetWithContext(ctx, path, &raw); err != nil {
+			return result, false, err
+		}
+
+		var state struct {
+			Status  string `json:"status"`
+			Comment string `json:"comment"`
+		}
+		if err := json.Unmarshal(raw, &state); err != nil {
+			return result, false, err
+		}
+		if err := json.Unmarshal(raw, &result); err != nil {
+			return result, false, err
+		}
+
+		switch taskStatuses[state.Status] {
+		case taskDone:
+			return result, true, nil
+		case taskFailed:
+			return result, false, &TaskError{Path: path, Status: state.Status, Comment: state.Comment}
+		}
+		return result, false, nil
+	}, options)
+}
+
+const (
+	taskPending = iota
+	taskDone
+	taskFailed
+)
+
+// taskStatuses classifies the final statuses of the tasks of the various
+// products. Other statuses, such as "todo", "doing" or "in-progress", are
+// pending.
+var taskStatuses = map[string]int{
+	"done":          taskDone,
+	"completed":     taskDone,
+	"cancelled":     taskFailed,
+	"canceled":      taskFailed,
+	"error":         taskFailed,
+	"customerError": taskFailed,
+	"ovhError":      taskFailed,
+	"in-error":      taskFailed,
+	"failed":        taskFailed,
+	"problem":       taskFailed,
+}
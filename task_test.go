@@ -0,0 +1,75 @@
+package govh
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWaitForTask(t *testing.T) {
+	options := &TaskOptions{Interval: time.Millisecond}
+
+	polls := 0
+	result, err := WaitForTask(context.Background(), func(ctx context.Context) (int, bool, error) {
+		polls++
+		return polls * 10, polls == 3, nil
+	}, options)
+	if err != nil || result != 30 || polls != 3 {
+		t.Fatalf("unexpected result %d after %d polls (%v)", result, polls, err)
+	}
+
+	fetchErr := errors.New("fetch failed")
+	if _, err := WaitForTask(context.Background(), func(ctx context.Context) (int, bool, error) {
+		return 0, false, fetchErr
+	}, options); err != fetchErr {
+		t.Fatalf("expected the fetch error, got %v", err)
+	}
+
+	pending := func(ctx context.Context) (int, bool, error) { return 0, false, nil }
+	if _, err := WaitForTask(context.Background(), pending, &TaskOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond}); err != ErrTaskTimeout {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := WaitForTask(ctx, pending, &TaskOptions{Interval: time.Millisecond, Timeout: time.Minute}); err != context.DeadlineExceeded {
+		t.Fatalf("expected the context error, got %v", err)
+	}
+}
+
+func TestWaitForTaskPath(t *testing.T) {
+	statuses := map[string][]string{
+		"/dedicated/server/ns1/task/1":    {"init", "todo", "doing", "done"},
+		"/domain/zone/example.com/task/2": {"todo", "error"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[r.URL.Path][0]
+		if len(statuses[r.URL.Path]) > 1 {
+			statuses[r.URL.Path] = statuses[r.URL.Path][1:]
+		}
+		w.Write([]byte(`{"taskId":1,"function":"hardReboot","status":"` + status + `","comment":"Invalid record"}`))
+	}))
+	defer server.Close()
+
+	type task struct {
+		TaskID   int64  `json:"taskId"`
+		Function string `json:"function"`
+		Status   string `json:"status"`
+	}
+
+	c := &Caller{URL: server.URL}
+	options := &TaskOptions{Interval: time.Millisecond}
+	result, err := WaitForTaskPath[task](context.Background(), c, "/dedicated/server/ns1/task/1", options)
+	if err != nil || result.Status != "done" || result.Function != "hardReboot" {
+		t.Fatalf("unexpected result %+v (%v)", result, err)
+	}
+
+	_, err = WaitForTaskPath[task](context.Background(), c, "/domain/zone/example.com/task/2", options)
+	var taskErr *TaskError
+	if !errors.As(err, &taskErr) || taskErr.Status != "error" || taskErr.Comment != "Invalid record" {
+		t.Fatalf("expected a task error, got %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package govh
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SyncTime computes the time lag between the local clock and the OVH API
+// clock, which is used to timestamp signed requests.
+func (caller *Caller) SyncTime() error {
+	return caller.SyncTimeWithContext(context.Background())
+}
+
+// SyncTimeWithContext is like SyncTime, but the request is bound to ctx.
+func (caller *Caller) SyncTimeWithContext(ctx context.Context) error {
+	ovhTime, err := caller.TimeWithContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// API time has a one second resolution, the lag is computed against the
+	// local time truncated the same way.
+	now := time.Now()
+
+	caller.mu.Lock()
+	caller.delay = ovhTime.Sub(now.Truncate(time.Second))
+	caller.syncedAt = now
+	caller.mu.Unlock()
+
+	return nil
+}
+
+// ensureTimeSync synchronizes time if the caller was created lazily and was
+// never synchronized, or if the last synchronization is older than
+// TimeSyncInterval.
+func (caller *Caller) ensureTimeSync(ctx context.Context) error {
+	caller.mu.RLock()
+	syncedAt := caller.syncedAt
+	caller.mu.RUnlock()
+
+	if syncedAt.IsZero() {
+		if !caller.lazySync && caller.TimeSyncInterval <= 0 {
+			return nil
+		}
+	} else if caller.TimeSyncInterval <= 0 || time.Since(syncedAt) < caller.TimeSyncInterval {
+		return nil
+	}
+
+	return caller.SyncTimeWithContext(ctx)
+}
+
+// isTimeSkewResponse tells whether the API rejected a request because of its
+// timestamp, which happens when the local clock drifted since the last time
+// synchronization.
+func isTimeSkewResponse(result *http.Response, body []byte) bool {
+	if result.StatusCode != http.StatusBadRequest && result.StatusCode != http.StatusUnauthorized && result.StatusCode != http.StatusForbidden {
+		return false
+	}
+
+	apiError := apiErrorFromResponse(result, body)
+	if errors.Is(apiError, ErrInvalidSignature) {
+		return true
+	}
+
+	// Older API versions don't send an error code. Other messages, even
+	// mentioning a timestamp, may come from a request which was applied.
+	return strings.EqualFold(strings.TrimSpace(apiError.Message), "query out of time")
+}
@@ -0,0 +1,139 @@
+package govh
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestLazyCaller(t *testing.T) {
+	ovhNow := time.Now().Add(-time.Hour)
+	var timeCalls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			timeCalls++
+			fmt.Fprint(w, ovhNow.Unix())
+			return
+		}
+
+		ts, _ := strconv.ParseInt(r.Header.Get("X-Ovh-Timestamp"), 10, 64)
+		if d := ts - ovhNow.Unix(); d < -2 || d > 2 {
+			t.Errorf("timestamp is %ds away from API time", d)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c, err := NewLazyCaller(server.URL, "ak", "as", "ck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if timeCalls != 0 {
+		t.Fatal("lazy caller should not call the API at construction")
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if timeCalls != 1 {
+		t.Fatalf("expected a single time synchronization, got %d", timeCalls)
+	}
+}
+
+func TestTimeSkewResync(t *testing.T) {
+	ovhNow := time.Now()
+	var timeCalls, calls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			timeCalls++
+			fmt.Fprint(w, ovhNow.Unix())
+			return
+		}
+
+		calls++
+		ts, _ := strconv.ParseInt(r.Header.Get("X-Ovh-Timestamp"), 10, 64)
+		if d := ts - ovhNow.Unix(); d < -2 || d > 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errorCode":"INVALID_SIGNATURE","message":"Invalid signature"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	// Simulate a clock that drifted since the last synchronization.
+	c := &Caller{URL: server.URL, delay: time.Hour, syncedAt: time.Now()}
+	if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if timeCalls != 1 || calls != 2 {
+		t.Fatalf("expected 1 resync and 2 calls, got %d and %d", timeCalls, calls)
+	}
+
+	// Periodic resynchronization.
+	c.TimeSyncInterval = time.Millisecond
+	time.Sleep(2 * time.Millisecond)
+	if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if timeCalls != 2 || calls != 3 {
+		t.Fatalf("expected 2 resyncs and 3 calls, got %d and %d", timeCalls, calls)
+	}
+}
+
+func TestTimeSkewResyncOnce(t *testing.T) {
+	var timeCalls, calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			timeCalls++
+			fmt.Fprint(w, time.Now().Unix())
+			return
+		}
+
+		calls++
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"errorCode":"QUERY_TIME_OUT","message":"Query out of time"}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "ck", syncedAt: time.Now()}
+	err := c.CallAPI("/domain", "POST", nil, nil)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expected an invalid signature error, got %v", err)
+	}
+	if timeCalls != 1 || calls != 2 {
+		t.Fatalf("expected 1 resync and 2 calls, got %d and %d", timeCalls, calls)
+	}
+}
+
+func TestTimeSkewValidationError(t *testing.T) {
+	var timeCalls, calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			timeCalls++
+			fmt.Fprint(w, time.Now().Unix())
+			return
+		}
+
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message":"Invalid timestamp for parameter startDate"}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "ck", syncedAt: time.Now()}
+	if err := c.CallAPI("/me/order", "POST", nil, nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	if timeCalls != 0 || calls != 1 {
+		t.Fatalf("expected the request to be sent once without resync, got %d calls and %d resyncs", calls, timeCalls)
+	}
+}
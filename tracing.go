@@ -0,0 +1,71 @@
+package govh
+
+import (
+	"context"
+	"net/http"
+)
+
+// Tracer starts a span for every call made by a Caller. Its shape follows
+// OpenTelemetry, so that an adapter is a few lines long:
+//
+//	type otelTracer struct{ trace.Tracer }
+//
+//	func (t otelTracer) Start(ctx context.Context, name string) (context.Context, govh.Span) {
+//		ctx, span := t.Tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		return ctx, otelSpan{span}
+//	}
+//
+// The context returned by Start is the one of the HTTP request, so that a
+// transport or middleware can propagate the span to the API.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// SetAttribute sets an attribute of the span. Values are strings or ints.
+	SetAttribute(key string, value interface{})
+	// RecordError records the failure of the call.
+	RecordError(err error)
+	// End ends the span.
+	End()
+}
+
+// Attributes set on the spans of calls.
+const (
+	AttributeHTTPMethod     = "http.method"
+	AttributeHTTPRoute      = "http.route"
+	AttributeHTTPURL        = "http.url"
+	AttributeHTTPStatusCode = "http.status_code"
+	AttributeOVHQueryID     = "ovh.query_id"
+)
+
+// startSpan starts the span of a call, if the caller has a tracer. The
+// returned function ends it with the outcome of the call.
+func (caller *Caller) startSpan(ctx context.Context, options *callOptions, method, path string) (context.Context, func(*http.Response, error)) {
+	if caller.Tracer == nil {
+		return ctx, func(*http.Response, error) {}
+	}
+
+	route := options.routeFor(path)
+	ctx, span := caller.Tracer.Start(ctx, "OVH "+method+" "+route)
+	span.SetAttribute(AttributeHTTPMethod, method)
+	span.SetAttribute(AttributeHTTPRoute, route)
+	span.SetAttribute(AttributeHTTPURL, options.buildURL(caller.URL, path))
+
+	return ctx, func(result *http.Response, err error) {
+		if result != nil {
+			span.SetAttribute(AttributeHTTPStatusCode, result.StatusCode)
+			if queryID := result.Header.Get("X-Ovh-QueryID"); queryID != "" {
+				span.SetAttribute(AttributeOVHQueryID, queryID)
+			}
+			if err == nil && !isSuccess(result.StatusCode) {
+				err = &ApiOvhError{Code: result.StatusCode, Message: http.StatusText(result.StatusCode)}
+			}
+		}
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}
+}
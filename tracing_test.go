@@ -0,0 +1,78 @@
+package govh
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type spanKey struct{}
+
+type testSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (span *testSpan) SetAttribute(key string, value interface{}) { span.attributes[key] = value }
+func (span *testSpan) RecordError(err error)                      { span.err = err }
+func (span *testSpan) End()                                       { span.ended = true }
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (tracer *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &testSpan{name: name, attributes: map[string]interface{}{}}
+	tracer.spans = append(tracer.spans, span)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func TestTracer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ovh-QueryID", "EU.ext-1.abc")
+		if r.URL.Path == "/me/bill/FR123" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	tracer := &testTracer{}
+	var spanInRequest interface{}
+	c := &Caller{URL: server.URL, Tracer: tracer}
+	c.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(request *http.Request) (*http.Response, error) {
+			spanInRequest = request.Context().Value(spanKey{})
+			return next(request)
+		}
+	})
+
+	if err := c.Get("/me", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Get("/me/bill/FR123", nil); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if len(tracer.spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.name != "OVH GET /me" || !span.ended || span.err != nil ||
+		span.attributes[AttributeHTTPStatusCode] != 200 || span.attributes[AttributeOVHQueryID] != "EU.ext-1.abc" ||
+		span.attributes[AttributeHTTPURL] != server.URL+"/me" {
+		t.Fatalf("unexpected span %+v", span)
+	}
+	span = tracer.spans[1]
+	if span.name != "OVH GET /me/bill/{id}" || span.attributes[AttributeHTTPRoute] != "/me/bill/{id}" || span.err == nil {
+		t.Fatalf("unexpected span %+v", span)
+	}
+	if spanInRequest != span {
+		t.Fatal("span was not propagated to the request context")
+	}
+}
@@ -0,0 +1,170 @@
+package govh
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ownTransport returns the HTTP transport of the caller, to be tuned by
+// options. The caller's client and transport are copied the first time, so
+// that a client given to WithHTTPClient, or http.DefaultClient, are never
+// modified.
+func (caller *Caller) ownTransport() (*http.Transport, error) {
+	if caller.transport != nil {
+		return caller.transport, nil
+	}
+
+	client := &http.Client{}
+	if caller.HTTPClient != nil {
+		*client = *caller.HTTPClient
+	}
+
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	transport, ok := base.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("Can't configure HTTP transport of type %T", base)
+	}
+
+	caller.transport = transport.Clone()
+	client.Transport = caller.transport
+	caller.HTTPClient = client
+
+	return caller.transport, nil
+}
+
+// transportOption builds an option tuning the caller's HTTP transport.
+func transportOption(configure func(*http.Transport)) Option {
+	return func(caller *Caller) error {
+		transport, err := caller.ownTransport()
+		if err != nil {
+			return err
+		}
+		configure(transport)
+		return nil
+	}
+}
+
+// WithMaxIdleConns sets the maximum number of idle connections kept open.
+func WithMaxIdleConns(n int) Option {
+	return transportOption(func(transport *http.Transport) {
+		transport.MaxIdleConns = n
+	})
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle connections kept
+// open to the API. Go defaults to 2, which limits throughput when many calls
+// are made concurrently.
+func WithMaxIdleConnsPerHost(n int) Option {
+	return transportOption(func(transport *http.Transport) {
+		transport.MaxIdleConnsPerHost = n
+	})
+}
+
+// WithMaxConnsPerHost limits the total number of connections opened to the
+// API. Zero means no limit.
+func WithMaxConnsPerHost(n int) Option {
+	return transportOption(func(transport *http.Transport) {
+		transport.MaxConnsPerHost = n
+	})
+}
+
+// WithIdleConnTimeout sets how long an idle connection is kept open.
+func WithIdleConnTimeout(timeout time.Duration) Option {
+	return transportOption(func(transport *http.Transport) {
+		transport.IdleConnTimeout = timeout
+	})
+}
+
+// WithTLSHandshakeTimeout sets the maximum duration of TLS handshakes.
+func WithTLSHandshakeTimeout(timeout time.Duration) Option {
+	return transportOption(func(transport *http.Transport) {
+		transport.TLSHandshakeTimeout = timeout
+	})
+}
+
+// WithProxy sends requests through the given HTTP proxy, e.g.
+// "http://proxy.example.com:3128".
+func WithProxy(proxyURL string) Option {
+	return func(caller *Caller) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("Invalid proxy URL %q: %s", proxyURL, err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("Invalid proxy URL %q", proxyURL)
+		}
+
+		return transportOption(func(transport *http.Transport) {
+			transport.Proxy = http.ProxyURL(u)
+		})(caller)
+	}
+}
+
+// WithProxyFromEnvironment uses the proxy defined by the HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables.
+func WithProxyFromEnvironment() Option {
+	return transportOption(func(transport *http.Transport) {
+		transport.Proxy = http.ProxyFromEnvironment
+	})
+}
+
+// WithTLSConfig sets the TLS configuration used to connect to the API.
+// The configuration is cloned.
+func WithTLSConfig(config *tls.Config) Option {
+	return transportOption(func(transport *http.Transport) {
+		transport.TLSClientConfig = config.Clone()
+	})
+}
+
+// WithRootCAs sets the certificate authorities trusted to connect to the API,
+// e.g. when a corporate proxy intercepts TLS connections.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return transportOption(func(transport *http.Transport) {
+		tlsConfig(transport).RootCAs = pool
+	})
+}
+
+// WithCABundle adds the PEM encoded certificates of the given file to the
+// system certificate authorities trusted to connect to the API.
+func WithCABundle(path string) Option {
+	return func(caller *Caller) error {
+		pem, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("No certificate found in %s", path)
+		}
+
+		return WithRootCAs(pool)(caller)
+	}
+}
+
+// WithMinTLSVersion sets the minimum TLS version accepted to connect to the
+// API, e.g. tls.VersionTLS13.
+func WithMinTLSVersion(version uint16) Option {
+	return transportOption(func(transport *http.Transport) {
+		tlsConfig(transport).MinVersion = version
+	})
+}
+
+// tlsConfig returns the TLS configuration of transport, creating it if needed.
+func tlsConfig(transport *http.Transport) *tls.Config {
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	return transport.TLSClientConfig
+}
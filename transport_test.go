@@ -0,0 +1,56 @@
+package govh
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTransportOptions(t *testing.T) {
+	c, err := NewClient("ovh-eu",
+		WithoutTimeSync(),
+		WithMaxIdleConnsPerHost(64),
+		WithIdleConnTimeout(time.Minute),
+		WithTLSHandshakeTimeout(5*time.Second),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport, ok := c.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("unexpected transport %T", c.HTTPClient.Transport)
+	}
+	if transport.MaxIdleConnsPerHost != 64 || transport.IdleConnTimeout != time.Minute || transport.TLSHandshakeTimeout != 5*time.Second {
+		t.Fatal("transport options were not applied")
+	}
+	if transport == http.DefaultTransport {
+		t.Fatal("default transport should not be modified")
+	}
+}
+
+func TestProxyAndTLSOptions(t *testing.T) {
+	c, err := NewClient("ovh-eu",
+		WithoutTimeSync(),
+		WithProxy("http://proxy.example.com:3128"),
+		WithMinTLSVersion(tls.VersionTLS13),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport := c.HTTPClient.Transport.(*http.Transport)
+	proxy, err := transport.Proxy(httptest.NewRequest("GET", "https://eu.api.ovh.com/1.0/me", nil))
+	if err != nil || proxy == nil || proxy.Host != "proxy.example.com:3128" {
+		t.Fatalf("unexpected proxy %v (%v)", proxy, err)
+	}
+	if transport.TLSClientConfig.MinVersion != tls.VersionTLS13 {
+		t.Fatal("minimum TLS version was not applied")
+	}
+
+	if _, err := NewClient("ovh-eu", WithoutTimeSync(), WithProxy("proxy.example.com")); err == nil {
+		t.Fatal("expected an error for an invalid proxy URL")
+	}
+}
@@ -0,0 +1,31 @@
+package govh
+
+import "context"
+
+// Get performs a GET request and returns its decoded result:
+//
+//	me, err := govh.Get[Me](caller, "/me")
+func Get[T any](client Client, path string) (T, error) {
+	return GetWithContext[T](context.Background(), client, path)
+}
+
+// GetWithContext is like Get, with a context and call options.
+func GetWithContext[T any](ctx context.Context, client Client, path string, opts ...CallOption) (T, error) {
+	var result T
+	err := client.GetWithContext(ctx, path, &result, opts...)
+	return result, err
+}
+
+// Post performs a POST request and returns its decoded result:
+//
+//	task, err := govh.Post[Task](caller, "/domain/zone/example.com/refresh", nil)
+func Post[T any](client Client, path string, body interface{}) (T, error) {
+	return PostWithContext[T](context.Background(), client, path, body)
+}
+
+// PostWithContext is like Post, with a context and call options.
+func PostWithContext[T any](ctx context.Context, client Client, path string, body interface{}, opts ...CallOption) (T, error) {
+	var result T
+	err := client.PostWithContext(ctx, path, body, &result, opts...)
+	return result, err
+}
@@ -0,0 +1,57 @@
+package govh
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTypedCalls(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/me":
+			w.Write([]byte(`{"nichandle":"xx1234-ovh"}`))
+		case r.Method == "POST" && r.URL.Path == "/domain/zone/example.com/record":
+			body, _ := ioutil.ReadAll(r.Body)
+			if len(body) == 0 {
+				body = []byte("null")
+			}
+			w.Write([]byte(`{"id":42,"echo":` + string(body) + `}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+
+	type me struct {
+		Nichandle string `json:"nichandle"`
+	}
+	account, err := Get[me](c, "/me")
+	if err != nil || account.Nichandle != "xx1234-ovh" {
+		t.Fatalf("unexpected result %+v (%v)", account, err)
+	}
+	if _, err := GetWithContext[*me](context.Background(), c, "/unknown"); !IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	type record struct {
+		ID   int64 `json:"id"`
+		Echo struct {
+			Target string `json:"target"`
+		} `json:"echo"`
+	}
+	created, err := Post[record](c, "/domain/zone/example.com/record", map[string]string{"target": "192.0.2.1"})
+	if err != nil || created.ID != 42 || created.Echo.Target != "192.0.2.1" {
+		t.Fatalf("unexpected result %+v (%v)", created, err)
+	}
+
+	id, err := PostWithContext[map[string]interface{}](context.Background(), c, "/domain/zone/example.com/record", nil)
+	if err != nil || id["id"] != float64(42) {
+		t.Fatalf("unexpected result %v (%v)", id, err)
+	}
+}
@@ -0,0 +1,30 @@
+package govh
+
+import (
+	"runtime"
+	"strings"
+)
+
+// Version is the version of this package.
+const Version = "0.1.0"
+
+// DefaultUserAgent is the User-Agent sent when Caller.UserAgent is empty.
+var DefaultUserAgent = "govh/" + Version + " " + strings.Replace(runtime.Version(), "go", "Go/", 1)
+
+// SetUserAgent identifies the application in the User-Agent sent to the API,
+// e.g. "my-app/1.2.3 govh/0.1.0 Go/1.21.0", so that it can be recognized in
+// OVH logs.
+func (caller *Caller) SetUserAgent(application, version string) {
+	ua := application
+	if version != "" {
+		ua += "/" + version
+	}
+	caller.UserAgent = ua + " " + DefaultUserAgent
+}
+
+func (caller *Caller) userAgent() string {
+	if caller.UserAgent == "" {
+		return DefaultUserAgent
+	}
+	return caller.UserAgent
+}
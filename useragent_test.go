@@ -0,0 +1,32 @@
+package govh
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUserAgent(t *testing.T) {
+	var ua string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ua = r.Header.Get("User-Agent")
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+	if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(ua, "govh/"+Version+" Go/") {
+		t.Fatalf("unexpected default User-Agent %q", ua)
+	}
+
+	c.SetUserAgent("backup-tool", "2.1.0")
+	if err := c.CallAPI("/me", "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(ua, "backup-tool/2.1.0 govh/") {
+		t.Fatalf("unexpected User-Agent %q", ua)
+	}
+}
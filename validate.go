@@ -0,0 +1,54 @@
+package govh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// validateCredentials checks the credentials of a caller signing requests with
+// its application keys, so that mistakes are reported at construction rather
+// than by a 403 on the first call.
+func validateCredentials(applicationKey, applicationSecret, consumerKey string) error {
+	if applicationKey == "" {
+		return errors.New("Missing application key")
+	}
+	if applicationSecret == "" {
+		return errors.New("Missing application secret")
+	}
+	// The consumer key is a secret: errors only tell where it is wrong.
+	for i, c := range consumerKey {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return fmt.Errorf("Invalid consumer key of length %d: character at position %d is not a letter or a digit", len(consumerKey), i+1)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the caller can perform signed calls: its credentials
+// are well formed, and the API accepts them. It returns an error if the
+// consumer key is unknown, expired, or not validated yet.
+func (caller *Caller) Validate(ctx context.Context) error {
+	if caller.Auth == nil {
+		consumerKey, _ := caller.credentials()
+		if err := validateCredentials(caller.ApplicationKey, caller.ApplicationSecret, consumerKey); err != nil {
+			return err
+		}
+		if consumerKey == "" {
+			return errors.New("Missing consumer key")
+		}
+	}
+
+	credential, err := caller.GetCurrentCredential(ctx)
+	switch {
+	case errors.Is(err, ErrInvalidKey):
+		return fmt.Errorf("Application key was rejected: %w", err)
+	case errors.Is(err, ErrInvalidCredential), errors.Is(err, ErrInvalidSignature):
+		return fmt.Errorf("Credentials were rejected: %w", err)
+	case err != nil:
+		return err
+	case credential.Status != "validated":
+		return fmt.Errorf("Consumer key is not usable: %s", credential.Status)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package govh
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCredentialsValidation(t *testing.T) {
+	for _, credentials := range [][3]string{
+		{"", "as", "ck"},
+		{"ak", "", "ck"},
+		{"ak", "as", "\"ck\""},
+		{"ak", "as", "ck\n"},
+	} {
+		if _, err := NewClient("ovh-eu", WithoutTimeSync(), WithCredentials(credentials[0], credentials[1], credentials[2])); err == nil {
+			t.Errorf("expected an error for credentials %q", credentials)
+		}
+	}
+
+	_, err := NewClient("ovh-eu", WithoutTimeSync(), WithCredentials("ak", "as", "sEcReTcK\n"))
+	if err == nil || strings.Contains(err.Error(), "sEcReTcK") || !strings.Contains(err.Error(), "position 9") {
+		t.Fatalf("expected an error locating the invalid character without the key, got %v", err)
+	}
+
+	if _, err := NewLazyCaller("ovh-eu", "ak", "as", ""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	status := "validated"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Ovh-Consumer") == "revoked" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errorCode":"INVALID_CREDENTIAL","message":"This credential is not valid"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"` + status + `"}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "ck"}
+	if err := c.Validate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	status = "pendingValidation"
+	if err := c.Validate(context.Background()); err == nil {
+		t.Fatal("expected an error for a pending consumer key")
+	}
+
+	c.ConsumerKey = "revoked"
+	if err := c.Validate(context.Background()); !IsInvalidCredential(err) {
+		t.Fatalf("expected an invalid credential error, got %v", err)
+	}
+
+	c.ConsumerKey = ""
+	if err := c.Validate(context.Background()); err == nil || errors.Is(err, ErrInvalidCredential) {
+		t.Fatalf("expected a missing consumer key error, got %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+package govh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WaitForValidation polls the API until the caller's consumer key is
+// validated by the user, typically after GetConsumerKey, by visiting the
+// validation URL. It returns an error if the key is refused or expires, or
+// when ctx is done. Interval defaults to 2 seconds when zero.
+func (caller *Caller) WaitForValidation(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+
+	for {
+		credential, err := caller.GetCurrentCredential(ctx)
+
+		switch {
+		case err == nil && credential.Status == "validated":
+			return nil
+		case err == nil && (credential.Status == "expired" || credential.Status == "refused"):
+			return fmt.Errorf("Consumer key was not validated: %s", credential.Status)
+		case err != nil && !isPendingCredentialError(err):
+			return err
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+// isPendingCredentialError tells whether err is returned by the API because
+// the consumer key is not validated yet. Other errors, such as a rejected
+// application key or a forbidden route, are returned at once.
+func isPendingCredentialError(err error) bool {
+	return errors.Is(err, ErrInvalidCredential)
+}
@@ -0,0 +1,59 @@
+package govh
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWaitForValidation(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 3 {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errorCode":"NOT_CREDENTIAL","message":"This credential is not valid"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"validated","credentialId":42}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+	if err := c.WaitForValidation(context.Background(), time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if polls != 3 {
+		t.Fatalf("expected 3 polls, got %d", polls)
+	}
+
+	polls = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if err := c.WaitForValidation(ctx, time.Second); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestWaitForValidationInvalidKey(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"errorCode":"INVALID_KEY","message":"This application key is invalid"}`))
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := c.WaitForValidation(ctx, time.Millisecond); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected an invalid key error, got %v", err)
+	}
+	if polls != 1 {
+		t.Fatalf("expected 1 poll, got %d", polls)
+	}
+}
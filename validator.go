@@ -0,0 +1,30 @@
+package govh
+
+import (
+	"net/url"
+	"strings"
+)
+
+// RequestValidator checks calls before they are sent, e.g. against the API
+// description with schema.Validator. An error aborts the call, and is
+// returned as is.
+type RequestValidator interface {
+	// ValidateRequest checks a call, given its path relative to the API
+	// version, such as "/domain/zone/example.com/record", its query
+	// parameters, and its JSON body.
+	ValidateRequest(method, path string, query url.Values, body []byte) error
+}
+
+// validateRequest checks a call with the caller's validator.
+func (caller *Caller) validateRequest(options *callOptions, method, path string, body []byte) error {
+	query := url.Values{}
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		query, _ = url.ParseQuery(path[i+1:])
+		path = path[:i]
+	}
+	for k, values := range options.query {
+		query[k] = append(query[k], values...)
+	}
+
+	return caller.Validator.ValidateRequest(method, path, query, body)
+}
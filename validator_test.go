@@ -0,0 +1,51 @@
+package govh
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type validatorFunc func(method, path string, query url.Values, body []byte) error
+
+func (f validatorFunc) ValidateRequest(method, path string, query url.Values, body []byte) error {
+	return f(method, path, query, body)
+}
+
+func TestRequestValidator(t *testing.T) {
+	sent := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent++
+		w.Write([]byte(`null`))
+	}))
+	defer server.Close()
+
+	invalid := errors.New("invalid record")
+	var gotPath, gotBody string
+	var gotQuery url.Values
+	c := &Caller{URL: server.URL, Validator: validatorFunc(func(method, path string, query url.Values, body []byte) error {
+		gotPath, gotQuery, gotBody = path, query, string(body)
+		if method == "POST" {
+			return invalid
+		}
+		return nil
+	})}
+
+	if err := c.Post("/domain/zone/example.com/record", map[string]string{"fieldType": "SPF"}, nil); err != invalid {
+		t.Fatalf("expected the validation error, got %v", err)
+	}
+	if sent != 0 || gotPath != "/domain/zone/example.com/record" || gotBody != `{"fieldType":"SPF"}` {
+		t.Fatalf("unexpected validation of %s %s (%d requests sent)", gotPath, gotBody, sent)
+	}
+
+	query := url.Values{"subDomain": {"www"}}
+	if err := c.CallAPIWithContext(context.Background(), "/domain/zone/example.com/record?fieldType=A", "GET", nil, nil, WithQuery(query)); err != nil {
+		t.Fatal(err)
+	}
+	if sent != 1 || gotPath != "/domain/zone/example.com/record" || gotQuery.Get("fieldType") != "A" || gotQuery.Get("subDomain") != "www" {
+		t.Fatalf("unexpected validation of %s %v", gotPath, gotQuery)
+	}
+}
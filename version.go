@@ -0,0 +1,41 @@
+package govh
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Known API versions.
+const (
+	APIVersion1 = "1.0"
+	APIVersion2 = "v2"
+)
+
+var versionSegment = regexp.MustCompile(`^v?[0-9]+(\.[0-9]+)?$`)
+
+// withAPIVersion replaces the version segment ending baseURL by version.
+// If baseURL doesn't end with a version, version is appended to it.
+func withAPIVersion(baseURL, version string) string {
+	baseURL = strings.TrimRight(baseURL, "/")
+	version = strings.Trim(version, "/")
+
+	if i := strings.LastIndex(baseURL, "/"); i >= 0 && versionSegment.MatchString(baseURL[i+1:]) {
+		baseURL = baseURL[:i]
+	}
+	return baseURL + "/" + version
+}
+
+// SetAPIVersion makes the caller target the given API version, e.g.
+// APIVersion2 to switch from https://eu.api.ovh.com/1.0 to
+// https://eu.api.ovh.com/v2.
+func (caller *Caller) SetAPIVersion(version string) {
+	caller.URL = withAPIVersion(caller.URL, version)
+}
+
+// WithAPIVersion makes a single call target the given API version, regardless
+// of the caller's URL.
+func WithAPIVersion(version string) CallOption {
+	return func(options *callOptions) {
+		options.apiVersion = version
+	}
+}
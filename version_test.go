@@ -0,0 +1,24 @@
+package govh
+
+import "testing"
+
+func TestWithAPIVersion(t *testing.T) {
+	for _, tc := range []struct {
+		base, version, expected string
+	}{
+		{"https://eu.api.ovh.com/1.0", APIVersion2, "https://eu.api.ovh.com/v2"},
+		{"https://eu.api.ovh.com/v2/", APIVersion1, "https://eu.api.ovh.com/1.0"},
+		{"https://gateway.example.com/ovh", "v2", "https://gateway.example.com/ovh/v2"},
+	} {
+		if got := withAPIVersion(tc.base, tc.version); got != tc.expected {
+			t.Errorf("withAPIVersion(%q, %q) = %q, expected %q", tc.base, tc.version, got, tc.expected)
+		}
+	}
+}
+
+func TestCallOptionsAPIVersion(t *testing.T) {
+	options := newCallOptions([]CallOption{WithAPIVersion(APIVersion2)})
+	if got := options.buildURL("https://eu.api.ovh.com/1.0", "/me"); got != "https://eu.api.ovh.com/v2/me" {
+		t.Fatalf("unexpected URL %q", got)
+	}
+}
@@ -0,0 +1,167 @@
+package govh
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// ErrUnknownLoginState is returned by WebLogin.Complete when the state token
+// is unknown, already used or expired.
+var ErrUnknownLoginState = errors.New("govh: unknown or expired login state")
+
+// LoginStateStore keeps the pending consumer keys of a WebLogin between the
+// redirection of the user to OVH and the callback.
+type LoginStateStore interface {
+	// Put stores the consumer key requested for state until expires.
+	Put(state, consumerKey string, expires time.Time) error
+	// Take returns the consumer key stored for state and removes it. It
+	// returns ErrUnknownLoginState if there is none or if it has expired.
+	Take(state string) (string, error)
+}
+
+// MemoryStateStore is a LoginStateStore keeping pending logins in memory.
+// It is safe for concurrent use.
+type MemoryStateStore struct {
+	mu      sync.Mutex
+	pending map[string]pendingLogin
+}
+
+type pendingLogin struct {
+	consumerKey string
+	expires     time.Time
+}
+
+// NewMemoryStateStore creates an empty in-memory state store.
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{pending: make(map[string]pendingLogin)}
+}
+
+// Put implements LoginStateStore. Expired logins are purged on the way.
+func (store *MemoryStateStore) Put(state, consumerKey string, expires time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	now := time.Now()
+	for s, login := range store.pending {
+		if now.After(login.expires) {
+			delete(store.pending, s)
+		}
+	}
+	store.pending[state] = pendingLogin{consumerKey: consumerKey, expires: expires}
+	return nil
+}
+
+// Take implements LoginStateStore.
+func (store *MemoryStateStore) Take(state string) (string, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	login, ok := store.pending[state]
+	if !ok {
+		return "", ErrUnknownLoginState
+	}
+	delete(store.pending, state)
+	if time.Now().After(login.expires) {
+		return "", ErrUnknownLoginState
+	}
+	return login.consumerKey, nil
+}
+
+// WebLogin lets the users of a website link their OVH account:
+//
+//	login := &govh.WebLogin{
+//		Caller:      caller,
+//		AccessRules: govh.ReadOnlyRules("/me"),
+//		CallbackURL: "https://example.com/ovh/callback",
+//		Store:       govh.NewMemoryStateStore(),
+//	}
+//
+//	// In the login handler:
+//	validationURL, err := login.Start(ctx)
+//	http.Redirect(w, r, validationURL, http.StatusFound)
+//
+//	// In the callback handler:
+//	userCaller, err := login.Complete(ctx, r.URL.Query().Get("state"))
+//
+// The state token is added to the callback URL, so the callback can find the
+// consumer key requested for the user.
+type WebLogin struct {
+	// Caller holding the application credentials. Its consumer key is not
+	// modified.
+	Caller *Caller
+	// Scope of the requested consumer keys.
+	AccessRules []*AccessRule
+	// URL the user is redirected to after validation.
+	CallbackURL string
+	// Storage of pending logins.
+	Store LoginStateStore
+	// How long the user has to validate the consumer key. Defaults to 15
+	// minutes.
+	StateTTL time.Duration
+}
+
+// Start requests a new consumer key and returns the URL the user must be
+// redirected to in order to validate it.
+func (login *WebLogin) Start(ctx context.Context) (string, error) {
+	state, err := newLoginState()
+	if err != nil {
+		return "", err
+	}
+
+	redirection, err := url.Parse(login.CallbackURL)
+	if err != nil {
+		return "", fmt.Errorf("Invalid callback URL %q: %s", login.CallbackURL, err)
+	}
+	query := redirection.Query()
+	query.Set("state", state)
+	redirection.RawQuery = query.Encode()
+
+	params := &GetCKParams{AccessRules: login.AccessRules, Redirection: redirection.String()}
+	response, err := login.Caller.Clone().GetConsumerKeyWithContext(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	ttl := login.StateTTL
+	if ttl <= 0 {
+		ttl = 15 * time.Minute
+	}
+	if err := login.Store.Put(state, response.ConsumerKey, time.Now().Add(ttl)); err != nil {
+		return "", err
+	}
+	return response.ValidationURL, nil
+}
+
+// Complete handles the callback of a login started with Start: it checks that
+// the consumer key requested for state has been validated, and returns a copy
+// of the caller using it.
+func (login *WebLogin) Complete(ctx context.Context, state string) (*Caller, error) {
+	consumerKey, err := login.Store.Take(state)
+	if err != nil {
+		return nil, err
+	}
+
+	caller := login.Caller.WithConsumerKey(consumerKey)
+	credential, err := caller.GetCurrentCredential(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if credential.Status != "validated" {
+		return nil, fmt.Errorf("Consumer key was not validated: %s", credential.Status)
+	}
+	return caller, nil
+}
+
+func newLoginState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
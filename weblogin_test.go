@@ -0,0 +1,78 @@
+package govh
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestWebLogin(t *testing.T) {
+	var redirection string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/credential":
+			var params GetCKParams
+			json.NewDecoder(r.Body).Decode(&params)
+			redirection = params.Redirection
+			w.Write([]byte(`{"consumerKey":"user-ck","state":"pendingValidation","validationUrl":"https://example.com/validate"}`))
+		case "/auth/currentCredential":
+			if r.Header.Get("X-Ovh-Consumer") != "user-ck" {
+				t.Errorf("unexpected consumer key %q", r.Header.Get("X-Ovh-Consumer"))
+			}
+			w.Write([]byte(`{"status":"validated"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := &Caller{URL: server.URL, ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "app-ck"}
+	login := &WebLogin{
+		Caller:      c,
+		AccessRules: ReadOnlyRules("/me"),
+		CallbackURL: "https://app.example.com/callback?next=%2Fhome",
+		Store:       NewMemoryStateStore(),
+	}
+
+	validationURL, err := login.Start(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if validationURL != "https://example.com/validate" {
+		t.Fatalf("unexpected validation URL %q", validationURL)
+	}
+	if c.ConsumerKey != "app-ck" {
+		t.Fatalf("caller consumer key changed to %q", c.ConsumerKey)
+	}
+
+	callback, err := url.Parse(redirection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := callback.Query().Get("state")
+	if state == "" || callback.Query().Get("next") != "/home" {
+		t.Fatalf("unexpected redirection %q", redirection)
+	}
+
+	userCaller, err := login.Complete(context.Background(), state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userCaller.ConsumerKey != "user-ck" {
+		t.Fatalf("unexpected consumer key %q", userCaller.ConsumerKey)
+	}
+
+	if _, err := login.Complete(context.Background(), state); err != ErrUnknownLoginState {
+		t.Fatalf("expected state to be used once, got %v", err)
+	}
+}
+
+func TestMemoryStateStoreExpiration(t *testing.T) {
+	store := NewMemoryStateStore()
+	store.Put("state", "ck", time.Now().Add(-time.Second))
+	if _, err := store.Take("state"); err != ErrUnknownLoginState {
+		t.Fatalf("expected expired state, got %v", err)
+	}
+}